@@ -1,28 +1,70 @@
 package a10go
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"log"
+	"mime/multipart"
+	"net"
+	"net/http"
+	"sort"
 	"strings"
+	"sync"
 	"unicode"
 )
 
-// Client is an api client
+// Client is an api client.
+//
+// A Client is safe for concurrent use by multiple goroutines once created by New.
+// Api calls issued concurrently share the current session. When several calls
+// find the session expired at the same time, a single re-login is performed and
+// all of them are retried with the new session. Login and Logout may be called
+// concurrently with other calls; calls racing with Logout may fail with an
+// invalid session.
 type Client struct {
-	host      string  // api host
-	sessionID string  // session id
-	opt       Options // client options
+	host    string       // api host
+	opt     Options      // client options
+	http    *http.Client // http client honoring TLS options
+	errHTTP error        // error building http client
+
+	mutex     sync.RWMutex // protects sessionID, username, password, partition
+	sessionID string       // session id
+	username  string       // credentials from last Login, used for re-login
+	password  string       // password from last Login
+	partition string       // active partition, restored on re-login
+
+	loginMutex sync.Mutex // serializes Login, re-login and SwitchPartition
+
+	unsaved uint32 // atomic counter: changes applied since last WriteMemory
 }
 
 // FuncPrintf is function type for debug Printf
 type FuncPrintf func(format string, v ...interface{})
 
+// FuncCredentials is function type for supplying credentials for automatic re-login
+type FuncCredentials func() (username, password string, err error)
+
+// FuncRelogin is function type for observing automatic re-login.
+// method is the api method that found the expired session, err is the re-login result.
+type FuncRelogin func(method string, err error)
+
 // Options specify parameters for the api client
 type Options struct {
-	Debug       bool       // enable debugging
-	DebugPrintf FuncPrintf // custom Printf function for debugging
-	Dry         bool       // do not change anything
+	Debug          bool            // enable debugging
+	DebugPrintf    FuncPrintf      // custom Printf function for debugging
+	Dry            bool            // do not change anything
+	TLS            TLSOptions      // TLS verification of the api host
+	DisableRelogin bool            // do not re-login automatically when the session expires
+	Credentials    FuncCredentials // credentials for automatic re-login (defaults to the ones given to Login)
+	OnRelogin      FuncRelogin     // called after every automatic re-login attempt
+	Retry          RetryPolicy     // retry policy for failed api calls
+	RateLimit      float64         // max requests per second (zero means unlimited)
+	RateBurst      int             // max burst of requests above RateLimit
+	MaxInFlight    int             // max concurrent requests (zero means unlimited)
+	Limiter        *Limiter        // shared limiter, overrides RateLimit/RateBurst/MaxInFlight
+	Partition      string          // partition activated after Login (empty means user default partition)
+	AutoSave       bool            // call WriteMemory after every successful batch or transaction
 }
 
 func (c *Client) debugf(format string, v ...interface{}) {
@@ -36,85 +78,208 @@ func New(host string, options Options) *Client {
 	if options.DebugPrintf == nil {
 		options.DebugPrintf = log.Printf // default debug Printf
 	}
-	return &Client{host: host, opt: options}
+	if options.Limiter == nil && (options.RateLimit > 0 || options.MaxInFlight > 0) {
+		options.Limiter = NewLimiter(options.RateLimit, options.RateBurst, options.MaxInFlight)
+	}
+	c := &Client{host: host, opt: options, partition: options.Partition}
+	tlsConf, errTLS := tlsConfig(options.TLS)
+	if errTLS != nil {
+		c.errHTTP = errTLS // reported by every api call
+		return c
+	}
+	c.http = newHTTPClient(tlsConf)
+	return c
+}
+
+// httpClient returns the http client, or the error found while building it from Options
+func (c *Client) httpClient() (*http.Client, error) {
+	return c.http, c.errHTTP
 }
 
-// Login opens a new session
+// Login opens a new session.
+// Credentials are kept for automatic re-login when the session expires (see Options.DisableRelogin).
+// The active partition (see Options.Partition and SwitchPartition) is activated for the new session.
 func (c *Client) Login(username, password string) error {
-	var errAuth error
-	c.sessionID, errAuth = a10v21Auth(c.host, username, password)
+	c.loginMutex.Lock()
+	defer c.loginMutex.Unlock()
+	return c.login(username, password)
+}
+
+// login opens a new session. The caller must hold loginMutex, so that the
+// partition activated for the new session is the one recorded in c.partition.
+func (c *Client) login(username, password string) error {
+	c.mutex.Lock()
+	c.username, c.password = username, password
+	partition := c.partition
+	c.mutex.Unlock()
+
+	sessionID, errAuth := a10v21Auth(c, username, password)
+
+	if errAuth == nil && partition != "" {
+		// activate partition before publishing session to other goroutines
+		errAuth = partitionActivate(c, sessionID, partition)
+	}
+
+	c.mutex.Lock()
+	c.sessionID = sessionID
+	c.mutex.Unlock()
+
 	return errAuth
 }
 
+// session returns the current session id
+func (c *Client) session() string {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.sessionID
+}
+
+// relogin opens a new session after the api reported staleSession as invalid for method.
+// If another goroutine has already replaced staleSession, relogin just reuses the new session.
+func (c *Client) relogin(method, staleSession string) error {
+	c.loginMutex.Lock()
+	defer c.loginMutex.Unlock()
+
+	if c.session() != staleSession {
+		c.debugf("relogin: method=%s session already renewed", method)
+		return nil
+	}
+
+	c.mutex.RLock()
+	username, password := c.username, c.password
+	c.mutex.RUnlock()
+
+	var err error
+	if c.opt.Credentials != nil {
+		username, password, err = c.opt.Credentials()
+	}
+	if err == nil {
+		err = c.login(username, password)
+	}
+	c.debugf("relogin: method=%s error: %v", method, err)
+	if c.opt.OnRelogin != nil {
+		c.opt.OnRelogin(method, err)
+	}
+	return err
+}
+
+// reloginNeeded checks whether the api response reports an invalid session that should trigger re-login
+func (c *Client) reloginNeeded(body []byte) bool {
+	if c.opt.DisableRelogin {
+		return false
+	}
+	c.mutex.RLock()
+	neverLogged := c.username == ""
+	c.mutex.RUnlock()
+	if neverLogged && c.opt.Credentials == nil {
+		return false
+	}
+	return sessionInvalid(body)
+}
+
 // Logout closes an existing session
 func (c *Client) Logout() error {
-	return a10v21Close(c.debugf, c.host, c.sessionID)
+	return a10v21Close(c)
 }
 
 // Get calls http GET for an specific api method
 func (c *Client) Get(method string) ([]byte, error) {
-	return a10SessionGet(c.debugf, c.host, method, c.sessionID)
+	return a10SessionGet(c, method)
 }
 
 // Post calls http POST for an specific api method
 func (c *Client) Post(method, body string) ([]byte, error) {
-	return a10SessionPost(c.opt.Dry, c.debugf, c.host, method, c.sessionID, body)
+	return a10SessionPost(c, method, body)
 }
 
 /*
 // Delete calls http DELETE for an specific api method
 func (c *Client) Delete(method, body string) ([]byte, error) {
-	return a10SessionDelete(c.debugf, c.host, method, c.sessionID, body)
+	return a10SessionDelete(c, method, body)
 }
 */
 
 // ServerList retrieves the full server list
 func (c *Client) ServerList() []A10Server {
-	return a10ServerList(c.debugf, c.host, c.sessionID)
+	list, _ := a10ServerList(c)
+	return list
 }
 
-// ServerCreate creates new server. ports is list of "portName,portProtocol"
+// ServerCreate creates new server. ports is list of "portName,portProtocol[,template=portTemplate]"
 func (c *Client) ServerCreate(name, host string, ports []string) error {
-	return serverPost(c, "slb.server.create", name, host, ports)
+	return serverPost(c, "slb.server.create", name, host, "", ports)
+}
+
+// ServerCreateTemplate creates new server attached to server template.
+// ports is list of "portName,portProtocol[,template=portTemplate]"
+func (c *Client) ServerCreateTemplate(name, host, template string, ports []string) error {
+	return serverPost(c, "slb.server.create", name, host, template, ports)
 }
 
-// ServerUpdate updates server. ports is list of "portName,portProtocol"
+// ServerUpdate updates server. ports is list of "portName,portProtocol[,template=portTemplate]"
+// The device merges ports into the existing port list, while an empty ports clears it.
+// See ServerPatch and ServerReplace for explicit semantics.
 func (c *Client) ServerUpdate(name, host string, ports []string) error {
-	return serverPost(c, "slb.server.update", name, host, ports)
+	return serverPost(c, "slb.server.update", name, host, "", ports)
 }
 
-func serverPost(c *Client, method, name, host string, ports []string) error {
+// ServerUpdateTemplate updates server, attaching it to server template.
+// ports is list of "portName,portProtocol[,template=portTemplate]"
+func (c *Client) ServerUpdateTemplate(name, host, template string, ports []string) error {
+	return serverPost(c, "slb.server.update", name, host, template, ports)
+}
+
+func serverPost(c *Client, method, name, host, template string, ports []string) error {
 
 	me := "serverPost"
 
 	format := `{
             "server": {
                 "name": "%s",
-                "host": "%s",
-                "status": 1,
+                %s,
+                "status": 1,%s
 		"port_list": [%s]
             }
         }
 `
 
+	var templateField string
+	if template != "" {
+		templateField = fmt.Sprintf(`
+                "template": "%s",`, template)
+	}
+
+	payload := fmt.Sprintf(format, name, hostFormat(host), templateField, serverPortList(c.debugf, ports))
+
+	return doPost(c, me, method, payload)
+}
+
+func serverPortList(debugf FuncPrintf, ports []string) string {
 	portList := ""
 	for _, p := range ports {
-		portName, portProto := splitPortProto(c.debugf, p)
-		portFmt := portFormat(portName, portProto)
+		portFmt := portFormatTemplate(parsePort(debugf, p))
 		if portList == "" {
 			portList = portFmt
 			continue
 		}
 		portList += "," + portFmt
 	}
-
-	payload := fmt.Sprintf(format, name, host, portList)
-
-	return doPost(c, me, method, payload)
+	return portList
 }
 
 // doPost requires a valid JSON response, otherwise signals error
 func doPost(c *Client, caller, method, payload string) error {
+	if err := doPostCheck(c, caller, method, payload); err != nil {
+		return err
+	}
+
+	c.markUnsaved()
+
+	return nil
+}
+
+// doPostCheck is doPost for calls that do not change running configuration
+func doPostCheck(c *Client, caller, method, payload string) error {
 	body, errPost := c.Post(method, payload)
 
 	if c.opt.Dry {
@@ -134,8 +299,31 @@ func doPost(c *Client, caller, method, payload string) error {
 	return nil
 }
 
+// parsePort parses "portName,portProtocol[,template=portTemplate]"
+func parsePort(debugf FuncPrintf, port string) A10Port {
+	number, proto := splitPortProto(debugf, port)
+	p := A10Port{Number: number, Protocol: proto}
+	for _, f := range strings.FieldsFunc(port, isSep) {
+		kv := strings.SplitN(f, "=", 2)
+		switch {
+		case len(kv) < 2:
+		case kv[0] == "template":
+			p.Template = kv[1]
+		default:
+			debugf("parsePort(%s): ignoring unsupported field=%s", port, kv[0])
+		}
+	}
+	return p
+}
+
+// splitPortProto extracts port and protocol from "portName,portProtocol[,field=value...]"
 func splitPortProto(debugf FuncPrintf, portProto string) (string, string) {
-	s := strings.FieldsFunc(portProto, isSep)
+	var s []string
+	for _, f := range strings.FieldsFunc(portProto, isSep) {
+		if !strings.Contains(f, "=") {
+			s = append(s, f)
+		}
+	}
 	count := len(s)
 	switch {
 	case count < 1:
@@ -154,6 +342,13 @@ func portFormat(port, protocol string) string {
 	return fmt.Sprintf(`{"port_num": %s, "protocol": %s}`, port, protocol)
 }
 
+func portFormatTemplate(p A10Port) string {
+	if p.Template == "" {
+		return portFormat(p.Number, p.Protocol)
+	}
+	return fmt.Sprintf(`{"port_num": %s, "protocol": %s, "template": "%s"}`, p.Number, p.Protocol, p.Template)
+}
+
 // ServerDelete deletes an existing server
 func (c *Client) ServerDelete(name string) error {
 
@@ -203,9 +398,53 @@ func badJSONResponse(debugf FuncPrintf, buf []byte) bool {
 	return false // good response
 }
 
+// apiFailure returns error for api response with status other than OK.
+// Responses without status are not considered failures.
+func apiFailure(buf []byte) error {
+	tab := map[string]interface{}{}
+	if errJSON := json.Unmarshal(buf, &tab); errJSON != nil {
+		return fmt.Errorf("apiFailure: json error: %v", errJSON)
+	}
+	response, isMap := tab["response"].(map[string]interface{})
+	if !isMap {
+		return nil
+	}
+	status, _ := response["status"].(string)
+	if status == "OK" {
+		return nil
+	}
+	e, _ := response["err"].(map[string]interface{})
+	return fmt.Errorf("api status=%s code=%v msg=%v", status, e["code"], e["msg"])
+}
+
+// errCodeInvalidSession is the aXAPI v2.1 error code for invalid or expired session id
+const errCodeInvalidSession = 1009
+
+// {"response": {"status": "fail", "err": {"code": 1009, "msg": "Invalid session ID"}}}
+func sessionInvalid(buf []byte) bool {
+	tab := map[string]interface{}{}
+	if json.Unmarshal(buf, &tab) != nil {
+		return false
+	}
+	response, isMap := tab["response"].(map[string]interface{})
+	if !isMap {
+		return false
+	}
+	e, isErrMap := response["err"].(map[string]interface{})
+	if !isErrMap {
+		return false
+	}
+	if code, isNum := e["code"].(float64); isNum && int(code) == errCodeInvalidSession {
+		return true
+	}
+	msg, _ := e["msg"].(string)
+	return strings.Contains(strings.ToLower(msg), "invalid session")
+}
+
 // ServiceGroupList retrieves the full server group list
 func (c *Client) ServiceGroupList() []A10ServiceGroup {
-	return a10ServiceGroupList(c.debugf, c.host, c.sessionID)
+	list, _ := a10ServiceGroupList(c)
+	return list
 }
 
 // ServiceGroupCreate creates new service group
@@ -216,6 +455,8 @@ func (c *Client) ServiceGroupCreate(name, protocol string, members []string) err
 
 // ServiceGroupUpdate updates service group
 // members is list of "serverName,portNumber"
+// The device merges members into the existing member list, while an empty members clears it.
+// See ServiceGroupPatch and ServiceGroupReplace for explicit semantics.
 func (c *Client) ServiceGroupUpdate(name, protocol string, members []string) error {
 	return serviceGroupPost(c, "slb.service_group.update", name, protocol, members)
 }
@@ -233,9 +474,15 @@ func serviceGroupPost(c *Client, method, name, protocol string, members []string
         }
 `
 
+	payload := fmt.Sprintf(format, name, protocol, serviceGroupMemberList(c.debugf, members))
+
+	return doPost(c, me, method, payload)
+}
+
+func serviceGroupMemberList(debugf FuncPrintf, members []string) string {
 	memberList := ""
 	for _, s := range members {
-		memberName, memberPort := splitMemberPortProto(c.debugf, s)
+		memberName, memberPort := splitMemberPortProto(debugf, s)
 		memberFmt := memberFormat(memberName, memberPort)
 		if memberList == "" {
 			memberList = memberFmt
@@ -243,10 +490,7 @@ func serviceGroupPost(c *Client, method, name, protocol string, members []string
 		}
 		memberList += "," + memberFmt
 	}
-
-	payload := fmt.Sprintf(format, name, protocol, memberList)
-
-	return doPost(c, me, method, payload)
+	return memberList
 }
 
 const defaultProtoTCP = "2"
@@ -285,63 +529,117 @@ func (c *Client) ServiceGroupDelete(name string) error {
 	return doPost(c, me, method, payload)
 }
 
+// ServiceGroupMemberAdd adds member to existing service group
+// member is "serverName,portNumber"
+func (c *Client) ServiceGroupMemberAdd(group, member string) error {
+	return serviceGroupMemberPost(c, "slb.service_group.member.create", group, member)
+}
+
+// ServiceGroupMemberDelete removes member from existing service group
+// member is "serverName,portNumber"
+func (c *Client) ServiceGroupMemberDelete(group, member string) error {
+	return serviceGroupMemberPost(c, "slb.service_group.member.delete", group, member)
+}
+
+func serviceGroupMemberPost(c *Client, method, group, member string) error {
+
+	me := "serviceGroupMemberPost"
+
+	format := `{ "name": "%s", "member": %s }`
+
+	memberName, memberPort := splitMemberPortProto(c.debugf, member)
+
+	payload := fmt.Sprintf(format, group, memberFormat(memberName, memberPort))
+
+	return doPost(c, me, method, payload)
+}
+
 // VirtualServerCreate creates new virtual server
-// virtualPorts is list of "serviceGroup,port,protocol"
+// address is "ipv4", "ipv6" or, for dual-stack virtual server, "ipv4,ipv6"
+// virtualPorts is list of "serviceGroup,port,protocol[,field=value...]" (see vport.go)
 func (c *Client) VirtualServerCreate(name, address string, virtualPorts []string) error {
-	return virtualServerPost(c, "slb.virtual_server.create", name, address, virtualPorts)
+	return virtualServerPost(c, "slb.virtual_server.create", name, address, "", virtualPorts)
+}
+
+// VirtualServerCreateTemplate creates new virtual server attached to virtual-server template
+// virtualPorts is list of "serviceGroup,port,protocol[,field=value...]" (see vport.go)
+func (c *Client) VirtualServerCreateTemplate(name, address, template string, virtualPorts []string) error {
+	return virtualServerPost(c, "slb.virtual_server.create", name, address, template, virtualPorts)
 }
 
 // VirtualServerUpdate updates virtual server
-// virtualPorts is list of "serviceGroup,port,protocol"
+// virtualPorts is list of "serviceGroup,port,protocol[,field=value...]" (see vport.go)
+// The device merges virtualPorts into the existing port list, while an empty virtualPorts clears it.
+// See VirtualServerPatch and VirtualServerReplace for explicit semantics.
 func (c *Client) VirtualServerUpdate(name, address string, virtualPorts []string) error {
-	return virtualServerPost(c, "slb.virtual_server.update", name, address, virtualPorts)
+	return virtualServerPost(c, "slb.virtual_server.update", name, address, "", virtualPorts)
+}
+
+// VirtualServerUpdateTemplate updates virtual server, attaching it to virtual-server template
+// virtualPorts is list of "serviceGroup,port,protocol[,field=value...]" (see vport.go)
+func (c *Client) VirtualServerUpdateTemplate(name, address, template string, virtualPorts []string) error {
+	return virtualServerPost(c, "slb.virtual_server.update", name, address, template, virtualPorts)
+}
+
+func virtualServerPost(c *Client, method, name, address, template string, virtualPorts []string) error {
+	var ports []A10VirtualPort
+	for _, p := range virtualPorts {
+		ports = append(ports, parseVirtualPort(c.debugf, p))
+	}
+	return virtualServerPostPorts(c, method, name, address, template, ports)
+}
+
+// virtualServerPostPorts is virtualServerPost for parsed virtual ports,
+// carrying fields not expressed in the string form, like AFlex
+func virtualServerPostPorts(c *Client, method, name, address, template string, virtualPorts []A10VirtualPort) error {
+	return virtualServerPostList(c, method, name, address, template, virtualPortListFormat(virtualPorts))
 }
 
-func virtualServerPost(c *Client, method, name, address string, virtualPorts []string) error {
+// virtualServerPostList is virtualServerPost for virtual ports already rendered as aXAPI json
+func virtualServerPostList(c *Client, method, name, address, template, vportList string) error {
 
 	me := "virtualServerPost"
 
 	format := `{
             "virtual_server": {
                 "name": "%s",
-                "address": "%s",
-                "status": 1,
+                %s,
+                "status": 1,%s
 		"vport_list": [%s]
             }
 	}
 `
 
-	portList := ""
-	for _, p := range virtualPorts {
-		serviceGroup, port, proto := splitVirtualPort(c.debugf, p)
-		portFmt := virtualPortFormat(serviceGroup, port, proto)
-		if portList == "" {
-			portList = portFmt
-			continue
-		}
-		portList += "," + portFmt
+	var templateField string
+	if template != "" {
+		templateField = fmt.Sprintf(`
+                "vip_template": "%s",`, template)
 	}
 
-	payload := fmt.Sprintf(format, name, address, portList)
+	payload := fmt.Sprintf(format, name, addressFormat(address), templateField, vportList)
 
 	return doPost(c, me, method, payload)
 }
 
-func virtualPortFormat(serviceGroup, port, protocol string) string {
-	return fmt.Sprintf(`{"port": %s, "service_group": "%s", "protocol": "%s"}`, port, serviceGroup, protocol)
+func virtualPortList(debugf FuncPrintf, virtualPorts []string) string {
+	var ports []A10VirtualPort
+	for _, p := range virtualPorts {
+		ports = append(ports, parseVirtualPort(debugf, p))
+	}
+	return virtualPortListFormat(ports)
 }
 
-func splitVirtualPort(debugf FuncPrintf, virtualPort string) (string, string, string) {
-	s := strings.FieldsFunc(virtualPort, isSep)
-	proto := defaultProtoTCP
-	count := len(s)
-	if count < 2 {
-		return "", "", proto
-	}
-	if count < 3 {
-		return s[0], s[1], proto
+func virtualPortListFormat(virtualPorts []A10VirtualPort) string {
+	portList := ""
+	for _, p := range virtualPorts {
+		portFmt := virtualPortFormat(p)
+		if portList == "" {
+			portList = portFmt
+			continue
+		}
+		portList += "," + portFmt
 	}
-	return s[0], s[1], s[2]
+	return portList
 }
 
 // VirtualServerDelete deletes an existing virtual server
@@ -360,14 +658,19 @@ func (c *Client) VirtualServerDelete(name string) error {
 
 // VirtualServerList retrieves the full virtual server list
 func (c *Client) VirtualServerList() []A10VServer {
-	return a10VirtualServerList(c.debugf, c.host, c.sessionID)
+	list, _ := a10VirtualServerList(c)
+	return list
 }
 
 // A10VServer is a virtual server for VirtualServerList()
 type A10VServer struct {
 	Name         string
-	Address      string
+	Address      string // "ipv4", "ipv6" or "ipv4,ipv6" for dual-stack virtual server
+	IPv4         net.IP // address
+	IPv6         net.IP // address_ipv6
 	VirtualPorts []A10VirtualPort
+	Partition    string // partition active when listed
+	Template     string // virtual-server template (vip_template)
 }
 
 // A10VirtualPort is a virtual port for A10VServer
@@ -375,13 +678,28 @@ type A10VirtualPort struct {
 	Port         string
 	Protocol     string
 	ServiceGroup string
+
+	CookiePersistenceTemplate        string // cookie_persistence_template
+	SourceIPPersistenceTemplate      string // source_ip_persistence_template
+	DestinationIPPersistenceTemplate string // destination_ip_persistence_template
+	ClientSSLTemplate                string // client_ssl_template
+	ServerSSLTemplate                string // server_ssl_template
+	HTTPTemplate                     string // http_template
+	TCPTemplate                      string // tcp_template
+	UDPTemplate                      string // udp_template
+	ConnReuseTemplate                string // conn_reuse_template
+	VirtualPortTemplate              string // vport_template
+	SourceNAT                        string // source_nat: NAT pool or pool group
+
+	AFlex []string // aflex_list, see AFlexBind. nil leaves device list unchanged
 }
 
 // A10ServiceGroup is a service group for ServiceGroupList()
 type A10ServiceGroup struct {
-	Name     string
-	Protocol string
-	Members  []A10SGMember
+	Name      string
+	Protocol  string
+	Members   []A10SGMember
+	Partition string // partition active when listed
 }
 
 // A10SGMember is a service group member for A10ServiceGroup
@@ -392,15 +710,19 @@ type A10SGMember struct {
 
 // A10Server is a server for ServerList()
 type A10Server struct {
-	Name  string
-	Host  string
-	Ports []A10Port
+	Name      string
+	Host      string // IPv4 address, IPv6 address or hostname
+	IP        net.IP // parsed host address, nil for hostname
+	Ports     []A10Port
+	Partition string // partition active when listed
+	Template  string // server template
 }
 
 // A10Port defines port/protocol for A10Server
 type A10Port struct {
 	Number   string
 	Protocol string
+	Template string // port template
 }
 
 // V3:
@@ -453,17 +775,20 @@ func mapGetValue(debugf FuncPrintf, tab map[string]interface{}, key string) stri
 	return fmt.Sprintf("%v", value)
 }
 
-func a10ServerList(debugf FuncPrintf, host, sessionID string) []A10Server {
+func a10ServerList(c *Client) ([]A10Server, error) {
 	var list []A10Server
 
-	servers, errGet := a10SessionGet(debugf, host, "slb.server.getAll", sessionID)
+	debugf := c.debugf
+	partition := c.Partition()
+
+	servers, errGet := a10SessionGet(c, "slb.server.getAll")
 	if errGet != nil {
-		return list
+		return list, errGet
 	}
 
 	sList := jsonExtractList(debugf, servers, "server_list")
 	if sList == nil {
-		return list
+		return list, apiFailure(servers)
 	}
 
 	for _, s := range sList {
@@ -473,14 +798,21 @@ func a10ServerList(debugf FuncPrintf, host, sessionID string) []A10Server {
 		}
 
 		name := mapGetStr(debugf, sMap, "name")
-		host := mapGetStr(debugf, sMap, "host")
-		server := A10Server{Name: name, Host: host}
+		host, ip := parseAddr(mapGetStr(debugf, sMap, "host"))
+		if host6, ip6 := parseAddr(mapGetStr(debugf, sMap, "host_ipv6")); host6 != "" {
+			host, ip = host6, ip6
+		}
+		server := A10Server{Name: name, Host: host, IP: ip, Partition: partition}
+		if _, found := sMap["template"]; found {
+			server.Template = mapGetStr(debugf, sMap, "template")
+		}
 
 		debugf("server: %s", name)
 
 		portList := sMap["port_list"]
 		pList, isList := portList.([]interface{})
 		if !isList {
+			list = append(list, server) // server without ports
 			continue
 		}
 		for _, p := range pList {
@@ -490,26 +822,33 @@ func a10ServerList(debugf FuncPrintf, host, sessionID string) []A10Server {
 			}
 			portNum := mapGetValue(debugf, pMap, "port_num")
 			proto := mapGetValue(debugf, pMap, "protocol")
-			server.Ports = append(server.Ports, A10Port{Number: portNum, Protocol: proto})
+			port := A10Port{Number: portNum, Protocol: proto}
+			if _, found := pMap["template"]; found {
+				port.Template = mapGetStr(debugf, pMap, "template")
+			}
+			server.Ports = append(server.Ports, port)
 		}
 
 		list = append(list, server)
 	}
 
-	return list
+	return list, nil
 }
 
-func a10ServiceGroupList(debugf FuncPrintf, host, sessionID string) []A10ServiceGroup {
+func a10ServiceGroupList(c *Client) ([]A10ServiceGroup, error) {
 	var list []A10ServiceGroup
 
-	groups, errGet := a10SessionGet(debugf, host, "slb.service_group.getAll", sessionID)
+	debugf := c.debugf
+	partition := c.Partition()
+
+	groups, errGet := a10SessionGet(c, "slb.service_group.getAll")
 	if errGet != nil {
-		return list
+		return list, errGet
 	}
 
 	sgList := jsonExtractList(debugf, groups, "service_group_list")
 	if sgList == nil {
-		return list
+		return list, apiFailure(groups)
 	}
 
 	for _, sg := range sgList {
@@ -520,7 +859,7 @@ func a10ServiceGroupList(debugf FuncPrintf, host, sessionID string) []A10Service
 
 		name := mapGetStr(debugf, sgMap, "name")
 		protocol := mapGetValue(debugf, sgMap, "protocol")
-		group := A10ServiceGroup{Name: name, Protocol: protocol}
+		group := A10ServiceGroup{Name: name, Protocol: protocol, Partition: partition}
 
 		debugf("service group: %s protocol=[%s]", name, protocol)
 
@@ -542,20 +881,23 @@ func a10ServiceGroupList(debugf FuncPrintf, host, sessionID string) []A10Service
 		list = append(list, group)
 	}
 
-	return list
+	return list, nil
 }
 
-func a10VirtualServerList(debugf FuncPrintf, host, sessionID string) []A10VServer {
+func a10VirtualServerList(c *Client) ([]A10VServer, error) {
 	var list []A10VServer
 
-	bodyVirtServers, errGet := a10SessionGet(debugf, host, "slb.virtual_server.getAll", sessionID)
+	debugf := c.debugf
+	partition := c.Partition()
+
+	bodyVirtServers, errGet := a10SessionGet(c, "slb.virtual_server.getAll")
 	if errGet != nil {
-		return list
+		return list, errGet
 	}
 
 	vsList := jsonExtractList(debugf, bodyVirtServers, "virtual_server_list")
 	if vsList == nil {
-		return list
+		return list, apiFailure(bodyVirtServers)
 	}
 
 	for _, vs := range vsList {
@@ -565,15 +907,20 @@ func a10VirtualServerList(debugf FuncPrintf, host, sessionID string) []A10VServe
 		}
 
 		name := mapGetStr(debugf, vsMap, "name")
-		addr := mapGetStr(debugf, vsMap, "address")
+		addr4, ip4 := parseAddr(mapGetStr(debugf, vsMap, "address"))
+		addr6, ip6 := parseAddr(mapGetStr(debugf, vsMap, "address_ipv6"))
 
 		debugf("virtual server: %s", name)
 
-		vServer := A10VServer{Name: name, Address: addr}
+		vServer := A10VServer{Name: name, Address: joinAddress(addr4, addr6), IPv4: ip4, IPv6: ip6, Partition: partition}
+		if _, found := vsMap["vip_template"]; found {
+			vServer.Template = mapGetStr(debugf, vsMap, "vip_template")
+		}
 
 		portList := vsMap["vport_list"]
 		pList, isList := portList.([]interface{})
 		if !isList {
+			list = append(list, vServer) // virtual server without ports
 			continue
 		}
 		for _, vp := range pList {
@@ -586,6 +933,7 @@ func a10VirtualServerList(debugf FuncPrintf, host, sessionID string) []A10VServe
 			pProto := mapGetValue(debugf, pMap, "protocol")
 
 			vPort := A10VirtualPort{ServiceGroup: sGroup, Port: pStr, Protocol: pProto}
+			parseVirtualPortFields(debugf, &vPort, pMap)
 
 			vServer.VirtualPorts = append(vServer.VirtualPorts, vPort)
 
@@ -595,7 +943,7 @@ func a10VirtualServerList(debugf FuncPrintf, host, sessionID string) []A10VServe
 		list = append(list, vServer)
 	}
 
-	return list
+	return list, nil
 }
 
 func jsonExtractList(debugf FuncPrintf, body []byte, listName string) []interface{} {
@@ -619,21 +967,90 @@ func jsonExtractList(debugf FuncPrintf, body []byte, listName string) []interfac
 	return slice
 }
 
-func a10SessionGet(debugf FuncPrintf, host, method, sessionID string) ([]byte, error) {
+func a10SessionGet(c *Client, method string) ([]byte, error) {
+	sessionID := c.session()
+	body, err := a10SessionGetOnce(c, method, sessionID)
+	if c.reloginNeeded(body) && c.relogin(method, sessionID) == nil {
+		return a10SessionGetOnce(c, method, c.session()) // retry once with new session
+	}
+	return body, err
+}
+
+func a10SessionGetOnce(c *Client, method, sessionID string) ([]byte, error) {
 	me := "a10SessionGet"
-	api := a10v21urlSession(host, method, sessionID)
-	debugf(me+": url=[%s]", api)
-	body, err := httpGet(api)
+	api := a10v21urlSession(c.host, method, sessionID)
+	c.debugf(me+": url=[%s]", api)
+	hc, errHTTP := c.httpClient()
+	if errHTTP != nil {
+		return nil, fmt.Errorf(me+": %v", errHTTP)
+	}
+	body, err := retryCall(c, method, false, func() ([]byte, error) {
+		return clientGet(hc, api)
+	})
 	if err != nil {
-		debugf(me+": api=[%s] error: %v", api, err)
+		c.debugf(me+": api=[%s] error: %v", api, err)
 	}
 	return body, err
 }
 
-func a10SessionPost(dry bool, debugf FuncPrintf, host, method, sessionID, body string) ([]byte, error) {
+func a10SessionPost(c *Client, method, body string) ([]byte, error) {
+	return a10SessionPostMode(c, method, contentTypeJSON, body, true)
+}
+
+// a10SessionPostRead posts api method that does not change device configuration,
+// hence it is issued even in dry mode and retried as a read.
+func a10SessionPostRead(c *Client, method, body string) ([]byte, error) {
+	return a10SessionPostMode(c, method, contentTypeJSON, body, false)
+}
+
+// a10SessionPostMode posts body of contentType. write tells whether method changes device configuration.
+func a10SessionPostMode(c *Client, method, contentType, body string, write bool) ([]byte, error) {
+	sessionID := c.session()
+	respBody, err := a10SessionPostOnce(c, method, sessionID, contentType, body, write)
+	if c.reloginNeeded(respBody) && c.relogin(method, sessionID) == nil {
+		return a10SessionPostOnce(c, method, c.session(), contentType, body, write) // retry once with new session
+	}
+	return respBody, err
+}
+
+// multipartUpload posts fields and file content as multipart form to write method.
+// The file is sent in form field "upload".
+func multipartUpload(c *Client, method string, fields map[string]string, fileName string, content []byte) ([]byte, error) {
+
+	me := "multipartUpload"
+
+	var names []string
+	for k := range fields {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	for _, k := range names {
+		if err := w.WriteField(k, fields[k]); err != nil {
+			return nil, fmt.Errorf(me+": %v", err)
+		}
+	}
+	part, errPart := w.CreateFormFile("upload", fileName)
+	if errPart != nil {
+		return nil, fmt.Errorf(me+": %v", errPart)
+	}
+	if _, err := part.Write(content); err != nil {
+		return nil, fmt.Errorf(me+": %v", err)
+	}
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf(me+": %v", err)
+	}
+
+	return a10SessionPostMode(c, method, w.FormDataContentType(), buf.String(), true)
+}
+
+func a10SessionPostOnce(c *Client, method, sessionID, contentType, body string, write bool) ([]byte, error) {
 	me := "a10SessionPost"
-	api := a10v21urlSession(host, method, sessionID)
-	debugf(me+": dry=%v url=[%s]", dry, api)
+	dry := c.opt.Dry && write
+	api := a10v21urlSession(c.host, method, sessionID)
+	c.debugf(me+": dry=%v url=[%s]", dry, api)
 	var respBody []byte
 	var err error
 	if dry {
@@ -641,53 +1058,73 @@ func a10SessionPost(dry bool, debugf FuncPrintf, host, method, sessionID, body s
 		str := `{"response": {"status": "OK", "err": {"msg": "mock response for dry mode"}}}`
 		respBody = []byte(str)
 	} else {
-		respBody, err = httpPostString(api, contentTypeJSON, body)
+		hc, errHTTP := c.httpClient()
+		if errHTTP != nil {
+			return nil, fmt.Errorf(me+": %v", errHTTP)
+		}
+		respBody, err = retryCall(c, method, write, func() ([]byte, error) {
+			return httpPostString(hc, api, contentType, body)
+		})
 	}
 	if err != nil {
-		debugf(me+": dry=%v api=[%s] error: %v", dry, api, err)
+		c.debugf(me+": dry=%v api=[%s] error: %v", dry, api, err)
 	}
 	return respBody, err
 }
 
 /*
-func a10SessionDelete(debugf FuncPrintf, host, method, sessionID, body string) ([]byte, error) {
+func a10SessionDelete(c *Client, method, body string) ([]byte, error) {
 	me := "a10SessionDelete"
-	api := a10v21urlSession(host, method, sessionID)
-	respBody, err := httpDeleteString(api, contentTypeJSON, body)
+	api := a10v21urlSession(c.host, method, c.session())
+	hc, errHTTP := c.httpClient()
+	if errHTTP != nil {
+		return nil, fmt.Errorf(me+": %v", errHTTP)
+	}
+	respBody, err := httpDeleteString(hc, api, contentTypeJSON, body)
 	if err != nil {
-		debugf(me+": api=[%s] error: %v", api, err)
+		c.debugf(me+": api=[%s] error: %v", api, err)
 	}
 	return respBody, err
 }
 */
 
 const contentTypeJSON = "application/json"
+const contentTypeText = "text/plain"
 
-func a10v21Close(debugf FuncPrintf, host, sessionID string) error {
+func a10v21Close(c *Client) error {
 
 	method := "session.close"
 
-	api := a10v21urlSession(host, method, sessionID)
+	sessionID := c.session()
+
+	api := a10v21urlSession(c.host, method, sessionID)
 
 	format := `{"session_id": "%s"}`
 	payload := fmt.Sprintf(format, sessionID)
 
-	body, errPost := httpPostString(api, contentTypeJSON, payload)
+	hc, errHTTP := c.httpClient()
+	if errHTTP != nil {
+		return fmt.Errorf("a10v21Close: method=%s: %v", method, errHTTP)
+	}
+
+	body, errPost := retryCall(c, method, true, func() ([]byte, error) {
+		return httpPostString(hc, api, contentTypeJSON, payload)
+	})
 
 	if errPost != nil {
 		return fmt.Errorf("a10v21Close: method=%s error: %v", method, errPost)
 	}
 
-	if badJSONResponse(debugf, body) {
+	if badJSONResponse(c.debugf, body) {
 		return fmt.Errorf("a10v21Close: method=%s bad response: [%s]", method, string(body))
 	}
 
 	return nil
 }
 
-func a10v21Auth(host, username, password string) (string, error) {
+func a10v21Auth(c *Client, username, password string) (string, error) {
 
-	body, errAuth := v21auth(host, username, password)
+	body, errAuth := v21auth(c, username, password)
 	if errAuth != nil {
 		return "", errAuth
 	}
@@ -712,12 +1149,20 @@ func a10v21Auth(host, username, password string) (string, error) {
 	return sessionID, nil
 }
 
-func v21auth(host, username, password string) ([]byte, error) {
+func v21auth(c *Client, username, password string) ([]byte, error) {
 
-	api := a10v21url(host, "authenticate")
+	api := a10v21url(c.host, "authenticate")
 
 	format := `{ "username": "%s", "password": "%s" }`
 	payload := fmt.Sprintf(format, username, password)
 
-	return httpPostString(api, contentTypeJSON, payload)
+	hc, errHTTP := c.httpClient()
+	if errHTTP != nil {
+		return nil, fmt.Errorf("v21auth: %v", errHTTP)
+	}
+
+	// authenticate does not change device state, hence it is retried as a read
+	return retryCall(c, "authenticate", false, func() ([]byte, error) {
+		return httpPostString(hc, api, contentTypeJSON, payload)
+	})
 }
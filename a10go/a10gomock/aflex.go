@@ -0,0 +1,68 @@
+package a10gomock
+
+import (
+	"github.com/udhos/a10-go-rest-client/a10go"
+)
+
+// AFlexList records call and returns AFlexListFunc result
+func (m *Mock) AFlexList() []a10go.A10AFlex {
+	m.record("AFlexList")
+	if m.AFlexListFunc == nil {
+		return nil
+	}
+	return m.AFlexListFunc()
+}
+
+// AFlexGet records call and returns AFlexGetFunc result
+func (m *Mock) AFlexGet(name string) (string, error) {
+	m.record("AFlexGet", name)
+	if m.AFlexGetFunc == nil {
+		return "", nil
+	}
+	return m.AFlexGetFunc(name)
+}
+
+// AFlexUpload records call and returns AFlexUploadFunc result
+func (m *Mock) AFlexUpload(name, script string) error {
+	m.record("AFlexUpload", name, script)
+	if m.AFlexUploadFunc == nil {
+		return nil
+	}
+	return m.AFlexUploadFunc(name, script)
+}
+
+// AFlexValidate records call and returns AFlexValidateFunc result
+func (m *Mock) AFlexValidate(name, script string) error {
+	m.record("AFlexValidate", name, script)
+	if m.AFlexValidateFunc == nil {
+		return nil
+	}
+	return m.AFlexValidateFunc(name, script)
+}
+
+// AFlexDelete records call and returns AFlexDeleteFunc result
+func (m *Mock) AFlexDelete(name string) error {
+	m.record("AFlexDelete", name)
+	if m.AFlexDeleteFunc == nil {
+		return nil
+	}
+	return m.AFlexDeleteFunc(name)
+}
+
+// AFlexBind records call and returns AFlexBindFunc result
+func (m *Mock) AFlexBind(virtualServer, virtualPort, aflex string) error {
+	m.record("AFlexBind", virtualServer, virtualPort, aflex)
+	if m.AFlexBindFunc == nil {
+		return nil
+	}
+	return m.AFlexBindFunc(virtualServer, virtualPort, aflex)
+}
+
+// AFlexUnbind records call and returns AFlexUnbindFunc result
+func (m *Mock) AFlexUnbind(virtualServer, virtualPort, aflex string) error {
+	m.record("AFlexUnbind", virtualServer, virtualPort, aflex)
+	if m.AFlexUnbindFunc == nil {
+		return nil
+	}
+	return m.AFlexUnbindFunc(virtualServer, virtualPort, aflex)
+}
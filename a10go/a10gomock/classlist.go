@@ -0,0 +1,68 @@
+package a10gomock
+
+import (
+	"github.com/udhos/a10-go-rest-client/a10go"
+)
+
+// ClassListList records call and returns ClassListListFunc result
+func (m *Mock) ClassListList() []a10go.A10ClassList {
+	m.record("ClassListList")
+	if m.ClassListListFunc == nil {
+		return nil
+	}
+	return m.ClassListListFunc()
+}
+
+// ClassListCreate records call and returns ClassListCreateFunc result
+func (m *Mock) ClassListCreate(cl a10go.A10ClassList) error {
+	m.record("ClassListCreate", cl)
+	if m.ClassListCreateFunc == nil {
+		return nil
+	}
+	return m.ClassListCreateFunc(cl)
+}
+
+// ClassListUpdate records call and returns ClassListUpdateFunc result
+func (m *Mock) ClassListUpdate(cl a10go.A10ClassList) error {
+	m.record("ClassListUpdate", cl)
+	if m.ClassListUpdateFunc == nil {
+		return nil
+	}
+	return m.ClassListUpdateFunc(cl)
+}
+
+// ClassListDelete records call and returns ClassListDeleteFunc result
+func (m *Mock) ClassListDelete(name string) error {
+	m.record("ClassListDelete", name)
+	if m.ClassListDeleteFunc == nil {
+		return nil
+	}
+	return m.ClassListDeleteFunc(name)
+}
+
+// ClassListAdd records call and returns ClassListAddFunc result
+func (m *Mock) ClassListAdd(name string, entries a10go.A10ClassList) error {
+	m.record("ClassListAdd", name, entries)
+	if m.ClassListAddFunc == nil {
+		return nil
+	}
+	return m.ClassListAddFunc(name, entries)
+}
+
+// ClassListRemove records call and returns ClassListRemoveFunc result
+func (m *Mock) ClassListRemove(name string, entries a10go.A10ClassList) error {
+	m.record("ClassListRemove", name, entries)
+	if m.ClassListRemoveFunc == nil {
+		return nil
+	}
+	return m.ClassListRemoveFunc(name, entries)
+}
+
+// ClassListImport records call and returns ClassListImportFunc result
+func (m *Mock) ClassListImport(name string, content []byte) error {
+	m.record("ClassListImport", name, content)
+	if m.ClassListImportFunc == nil {
+		return nil
+	}
+	return m.ClassListImportFunc(name, content)
+}
@@ -0,0 +1,640 @@
+// Package a10gomock provides an in-memory mock implementation of a10go.API.
+//
+// Mock records every call and returns values scripted by tests through its
+// Func fields. A nil Func field returns zero values.
+//
+//	m := &a10gomock.Mock{}
+//	m.ServerCreateFunc = func(name, host string, ports []string) error {
+//		return errors.New("no such server")
+//	}
+//	runService(m) // code under test takes a10go.API
+//	calls := m.CallsTo("ServerCreate")
+package a10gomock
+
+import (
+	"sync"
+	"time"
+
+	"github.com/udhos/a10-go-rest-client/a10go"
+)
+
+// Call is a recorded call to Mock
+type Call struct {
+	Method string        // name of the API method
+	Args   []interface{} // call arguments
+}
+
+// Mock is an a10go.API implementation for testing.
+// Mock is safe for concurrent use.
+type Mock struct {
+	LoginFunc  func(username, password string) error
+	LogoutFunc func() error
+
+	GetFunc  func(method string) ([]byte, error)
+	PostFunc func(method, body string) ([]byte, error)
+
+	PartitionListFunc   func() []a10go.A10Partition
+	SwitchPartitionFunc func(name string) error
+	PartitionFunc       func() string
+
+	WriteMemoryFunc          func() error
+	WriteMemoryPartitionFunc func(partition string) error
+	WriteMemoryAllFunc       func() error
+	UnsavedFunc              func() bool
+
+	CLIShowFunc   func(cmd string) (string, error)
+	CLIDeployFunc func(commands []string) (string, error)
+
+	ServerListFunc           func() []a10go.A10Server
+	ServerCreateFunc         func(name, host string, ports []string) error
+	ServerUpdateFunc         func(name, host string, ports []string) error
+	ServerCreateTemplateFunc func(name, host, template string, ports []string) error
+	ServerUpdateTemplateFunc func(name, host, template string, ports []string) error
+	ServerDeleteFunc         func(name string) error
+
+	ServiceGroupListFunc         func() []a10go.A10ServiceGroup
+	ServiceGroupCreateFunc       func(name, protocol string, members []string) error
+	ServiceGroupUpdateFunc       func(name, protocol string, members []string) error
+	ServiceGroupDeleteFunc       func(name string) error
+	ServiceGroupMemberAddFunc    func(group, member string) error
+	ServiceGroupMemberDeleteFunc func(group, member string) error
+
+	VirtualServerListFunc           func() []a10go.A10VServer
+	VirtualServerCreateFunc         func(name, address string, virtualPorts []string) error
+	VirtualServerUpdateFunc         func(name, address string, virtualPorts []string) error
+	VirtualServerCreateTemplateFunc func(name, address, template string, virtualPorts []string) error
+	VirtualServerUpdateTemplateFunc func(name, address, template string, virtualPorts []string) error
+	VirtualServerDeleteFunc         func(name string) error
+
+	ServerCreateBatchFunc          func(servers []a10go.A10Server, opt a10go.BatchOptions) ([]a10go.BatchResult, error)
+	ServerDeleteBatchFunc          func(names []string, opt a10go.BatchOptions) ([]a10go.BatchResult, error)
+	ServiceGroupMemberAddBatchFunc func(group string, members []string, opt a10go.BatchOptions) ([]a10go.BatchResult, error)
+
+	ServerEnsureFunc                func(name, host string, ports []string) (a10go.EnsureResult, error)
+	ServerEnsureTemplateFunc        func(name, host, template string, ports []string) (a10go.EnsureResult, error)
+	ServiceGroupEnsureFunc          func(name, protocol string, members []string) (a10go.EnsureResult, error)
+	VirtualServerEnsureFunc         func(name, address string, virtualPorts []string) (a10go.EnsureResult, error)
+	VirtualServerEnsureTemplateFunc func(name, address, template string, virtualPorts []string) (a10go.EnsureResult, error)
+
+	ServerPatchFunc                  func(name string, spec a10go.ServerPatchSpec) error
+	ServerReplaceFunc                func(name, host string, ports []string) error
+	ServerReplaceTemplateFunc        func(name, host, template string, ports []string) error
+	ServiceGroupPatchFunc            func(name string, spec a10go.ServiceGroupPatchSpec) error
+	ServiceGroupReplaceFunc          func(name, protocol string, members []string) error
+	VirtualServerPatchFunc           func(name string, spec a10go.VirtualServerPatchSpec) error
+	VirtualServerReplaceFunc         func(name, address string, virtualPorts []string) error
+	VirtualServerReplaceTemplateFunc func(name, address, template string, virtualPorts []string) error
+
+	BeginFunc    func() a10go.Transaction
+	TxCommitFunc func(tx *Tx) error // Commit of transactions returned by default Begin
+
+	CookiePersistenceTemplateListFunc   func() []a10go.A10CookiePersistenceTemplate
+	CookiePersistenceTemplateCreateFunc func(t a10go.A10CookiePersistenceTemplate) error
+	CookiePersistenceTemplateUpdateFunc func(t a10go.A10CookiePersistenceTemplate) error
+	CookiePersistenceTemplateDeleteFunc func(name string) error
+	SrcIPPersistenceTemplateListFunc    func() []a10go.A10SrcIPPersistenceTemplate
+	SrcIPPersistenceTemplateCreateFunc  func(t a10go.A10SrcIPPersistenceTemplate) error
+	SrcIPPersistenceTemplateUpdateFunc  func(t a10go.A10SrcIPPersistenceTemplate) error
+	SrcIPPersistenceTemplateDeleteFunc  func(name string) error
+	DstIPPersistenceTemplateListFunc    func() []a10go.A10DstIPPersistenceTemplate
+	DstIPPersistenceTemplateCreateFunc  func(t a10go.A10DstIPPersistenceTemplate) error
+	DstIPPersistenceTemplateUpdateFunc  func(t a10go.A10DstIPPersistenceTemplate) error
+	DstIPPersistenceTemplateDeleteFunc  func(name string) error
+
+	ClientSSLTemplateListFunc   func() []a10go.A10ClientSSLTemplate
+	ClientSSLTemplateCreateFunc func(t a10go.A10ClientSSLTemplate) error
+	ClientSSLTemplateUpdateFunc func(t a10go.A10ClientSSLTemplate) error
+	ClientSSLTemplateDeleteFunc func(name string) error
+	ServerSSLTemplateListFunc   func() []a10go.A10ServerSSLTemplate
+	ServerSSLTemplateCreateFunc func(t a10go.A10ServerSSLTemplate) error
+	ServerSSLTemplateUpdateFunc func(t a10go.A10ServerSSLTemplate) error
+	ServerSSLTemplateDeleteFunc func(name string) error
+
+	SSLCertListFunc   func() []a10go.A10SSLCert
+	SSLCertGetFunc    func(name string) (a10go.A10SSLCert, error)
+	SSLCertUploadFunc func(name string, certPEM, keyPEM []byte, passphrase string) (a10go.A10SSLCert, error)
+	SSLCertDeleteFunc func(name string) error
+
+	CertExpiringFunc func(within time.Duration) ([]a10go.CertExpiry, error)
+	CertRotateFunc   func(template, name string, certPEM, keyPEM []byte, passphrase string) (a10go.A10SSLCert, error)
+
+	HTTPTemplateListFunc   func() []a10go.A10HTTPTemplate
+	HTTPTemplateCreateFunc func(t a10go.A10HTTPTemplate) error
+	HTTPTemplateUpdateFunc func(t a10go.A10HTTPTemplate) error
+	HTTPTemplateDeleteFunc func(name string) error
+
+	TCPTemplateListFunc         func() []a10go.A10TCPTemplate
+	TCPTemplateCreateFunc       func(t a10go.A10TCPTemplate) error
+	TCPTemplateUpdateFunc       func(t a10go.A10TCPTemplate) error
+	TCPTemplateDeleteFunc       func(name string) error
+	UDPTemplateListFunc         func() []a10go.A10UDPTemplate
+	UDPTemplateCreateFunc       func(t a10go.A10UDPTemplate) error
+	UDPTemplateUpdateFunc       func(t a10go.A10UDPTemplate) error
+	UDPTemplateDeleteFunc       func(name string) error
+	ConnReuseTemplateListFunc   func() []a10go.A10ConnReuseTemplate
+	ConnReuseTemplateCreateFunc func(t a10go.A10ConnReuseTemplate) error
+	ConnReuseTemplateUpdateFunc func(t a10go.A10ConnReuseTemplate) error
+	ConnReuseTemplateDeleteFunc func(name string) error
+
+	ServerTemplateListFunc   func() []a10go.A10ServerTemplate
+	ServerTemplateCreateFunc func(t a10go.A10ServerTemplate) error
+	ServerTemplateUpdateFunc func(t a10go.A10ServerTemplate) error
+	ServerTemplateDeleteFunc func(name string) error
+	PortTemplateListFunc     func() []a10go.A10PortTemplate
+	PortTemplateCreateFunc   func(t a10go.A10PortTemplate) error
+	PortTemplateUpdateFunc   func(t a10go.A10PortTemplate) error
+	PortTemplateDeleteFunc   func(name string) error
+
+	VirtualServerTemplateListFunc   func() []a10go.A10VirtualServerTemplate
+	VirtualServerTemplateCreateFunc func(t a10go.A10VirtualServerTemplate) error
+	VirtualServerTemplateUpdateFunc func(t a10go.A10VirtualServerTemplate) error
+	VirtualServerTemplateDeleteFunc func(name string) error
+	VirtualPortTemplateListFunc     func() []a10go.A10VirtualPortTemplate
+	VirtualPortTemplateCreateFunc   func(t a10go.A10VirtualPortTemplate) error
+	VirtualPortTemplateUpdateFunc   func(t a10go.A10VirtualPortTemplate) error
+	VirtualPortTemplateDeleteFunc   func(name string) error
+
+	NATPoolListFunc        func() []a10go.A10NATPool
+	NATPoolCreateFunc      func(p a10go.A10NATPool) error
+	NATPoolUpdateFunc      func(p a10go.A10NATPool) error
+	NATPoolDeleteFunc      func(name string) error
+	NATPoolGroupListFunc   func() []a10go.A10NATPoolGroup
+	NATPoolGroupCreateFunc func(g a10go.A10NATPoolGroup) error
+	NATPoolGroupUpdateFunc func(g a10go.A10NATPoolGroup) error
+	NATPoolGroupDeleteFunc func(name string) error
+
+	AFlexListFunc     func() []a10go.A10AFlex
+	AFlexGetFunc      func(name string) (string, error)
+	AFlexUploadFunc   func(name, script string) error
+	AFlexValidateFunc func(name, script string) error
+	AFlexDeleteFunc   func(name string) error
+	AFlexBindFunc     func(virtualServer, virtualPort, aflex string) error
+	AFlexUnbindFunc   func(virtualServer, virtualPort, aflex string) error
+
+	ClassListListFunc   func() []a10go.A10ClassList
+	ClassListCreateFunc func(cl a10go.A10ClassList) error
+	ClassListUpdateFunc func(cl a10go.A10ClassList) error
+	ClassListDeleteFunc func(name string) error
+	ClassListAddFunc    func(name string, entries a10go.A10ClassList) error
+	ClassListRemoveFunc func(name string, entries a10go.A10ClassList) error
+	ClassListImportFunc func(name string, content []byte) error
+
+	mutex sync.Mutex
+	calls []Call
+}
+
+var _ a10go.API = (*Mock)(nil) // Mock must implement a10go.API
+
+func (m *Mock) record(method string, args ...interface{}) {
+	m.mutex.Lock()
+	m.calls = append(m.calls, Call{Method: method, Args: args})
+	m.mutex.Unlock()
+}
+
+// Calls returns all recorded calls in order
+func (m *Mock) Calls() []Call {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return append([]Call(nil), m.calls...)
+}
+
+// CallsTo returns recorded calls for an specific API method
+func (m *Mock) CallsTo(method string) []Call {
+	var list []Call
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			list = append(list, c)
+		}
+	}
+	return list
+}
+
+// Reset forgets all recorded calls
+func (m *Mock) Reset() {
+	m.mutex.Lock()
+	m.calls = nil
+	m.mutex.Unlock()
+}
+
+// Login records call and returns LoginFunc result
+func (m *Mock) Login(username, password string) error {
+	m.record("Login", username, password)
+	if m.LoginFunc == nil {
+		return nil
+	}
+	return m.LoginFunc(username, password)
+}
+
+// Logout records call and returns LogoutFunc result
+func (m *Mock) Logout() error {
+	m.record("Logout")
+	if m.LogoutFunc == nil {
+		return nil
+	}
+	return m.LogoutFunc()
+}
+
+// Get records call and returns GetFunc result
+func (m *Mock) Get(method string) ([]byte, error) {
+	m.record("Get", method)
+	if m.GetFunc == nil {
+		return nil, nil
+	}
+	return m.GetFunc(method)
+}
+
+// Post records call and returns PostFunc result
+func (m *Mock) Post(method, body string) ([]byte, error) {
+	m.record("Post", method, body)
+	if m.PostFunc == nil {
+		return nil, nil
+	}
+	return m.PostFunc(method, body)
+}
+
+// PartitionList records call and returns PartitionListFunc result
+func (m *Mock) PartitionList() []a10go.A10Partition {
+	m.record("PartitionList")
+	if m.PartitionListFunc == nil {
+		return nil
+	}
+	return m.PartitionListFunc()
+}
+
+// SwitchPartition records call and returns SwitchPartitionFunc result
+func (m *Mock) SwitchPartition(name string) error {
+	m.record("SwitchPartition", name)
+	if m.SwitchPartitionFunc == nil {
+		return nil
+	}
+	return m.SwitchPartitionFunc(name)
+}
+
+// Partition records call and returns PartitionFunc result
+func (m *Mock) Partition() string {
+	m.record("Partition")
+	if m.PartitionFunc == nil {
+		return ""
+	}
+	return m.PartitionFunc()
+}
+
+// WriteMemory records call and returns WriteMemoryFunc result
+func (m *Mock) WriteMemory() error {
+	m.record("WriteMemory")
+	if m.WriteMemoryFunc == nil {
+		return nil
+	}
+	return m.WriteMemoryFunc()
+}
+
+// WriteMemoryPartition records call and returns WriteMemoryPartitionFunc result
+func (m *Mock) WriteMemoryPartition(partition string) error {
+	m.record("WriteMemoryPartition", partition)
+	if m.WriteMemoryPartitionFunc == nil {
+		return nil
+	}
+	return m.WriteMemoryPartitionFunc(partition)
+}
+
+// WriteMemoryAll records call and returns WriteMemoryAllFunc result
+func (m *Mock) WriteMemoryAll() error {
+	m.record("WriteMemoryAll")
+	if m.WriteMemoryAllFunc == nil {
+		return nil
+	}
+	return m.WriteMemoryAllFunc()
+}
+
+// Unsaved records call and returns UnsavedFunc result
+func (m *Mock) Unsaved() bool {
+	m.record("Unsaved")
+	if m.UnsavedFunc == nil {
+		return false
+	}
+	return m.UnsavedFunc()
+}
+
+// CLIShow records call and returns CLIShowFunc result
+func (m *Mock) CLIShow(cmd string) (string, error) {
+	m.record("CLIShow", cmd)
+	if m.CLIShowFunc == nil {
+		return "", nil
+	}
+	return m.CLIShowFunc(cmd)
+}
+
+// CLIDeploy records call and returns CLIDeployFunc result
+func (m *Mock) CLIDeploy(commands []string) (string, error) {
+	m.record("CLIDeploy", commands)
+	if m.CLIDeployFunc == nil {
+		return "", nil
+	}
+	return m.CLIDeployFunc(commands)
+}
+
+// ServerList records call and returns ServerListFunc result
+func (m *Mock) ServerList() []a10go.A10Server {
+	m.record("ServerList")
+	if m.ServerListFunc == nil {
+		return nil
+	}
+	return m.ServerListFunc()
+}
+
+// ServerCreate records call and returns ServerCreateFunc result
+func (m *Mock) ServerCreate(name, host string, ports []string) error {
+	m.record("ServerCreate", name, host, ports)
+	if m.ServerCreateFunc == nil {
+		return nil
+	}
+	return m.ServerCreateFunc(name, host, ports)
+}
+
+// ServerUpdate records call and returns ServerUpdateFunc result
+func (m *Mock) ServerUpdate(name, host string, ports []string) error {
+	m.record("ServerUpdate", name, host, ports)
+	if m.ServerUpdateFunc == nil {
+		return nil
+	}
+	return m.ServerUpdateFunc(name, host, ports)
+}
+
+// ServerCreateTemplate records call and returns ServerCreateTemplateFunc result
+func (m *Mock) ServerCreateTemplate(name, host, template string, ports []string) error {
+	m.record("ServerCreateTemplate", name, host, template, ports)
+	if m.ServerCreateTemplateFunc == nil {
+		return nil
+	}
+	return m.ServerCreateTemplateFunc(name, host, template, ports)
+}
+
+// ServerUpdateTemplate records call and returns ServerUpdateTemplateFunc result
+func (m *Mock) ServerUpdateTemplate(name, host, template string, ports []string) error {
+	m.record("ServerUpdateTemplate", name, host, template, ports)
+	if m.ServerUpdateTemplateFunc == nil {
+		return nil
+	}
+	return m.ServerUpdateTemplateFunc(name, host, template, ports)
+}
+
+// ServerDelete records call and returns ServerDeleteFunc result
+func (m *Mock) ServerDelete(name string) error {
+	m.record("ServerDelete", name)
+	if m.ServerDeleteFunc == nil {
+		return nil
+	}
+	return m.ServerDeleteFunc(name)
+}
+
+// ServiceGroupList records call and returns ServiceGroupListFunc result
+func (m *Mock) ServiceGroupList() []a10go.A10ServiceGroup {
+	m.record("ServiceGroupList")
+	if m.ServiceGroupListFunc == nil {
+		return nil
+	}
+	return m.ServiceGroupListFunc()
+}
+
+// ServiceGroupCreate records call and returns ServiceGroupCreateFunc result
+func (m *Mock) ServiceGroupCreate(name, protocol string, members []string) error {
+	m.record("ServiceGroupCreate", name, protocol, members)
+	if m.ServiceGroupCreateFunc == nil {
+		return nil
+	}
+	return m.ServiceGroupCreateFunc(name, protocol, members)
+}
+
+// ServiceGroupUpdate records call and returns ServiceGroupUpdateFunc result
+func (m *Mock) ServiceGroupUpdate(name, protocol string, members []string) error {
+	m.record("ServiceGroupUpdate", name, protocol, members)
+	if m.ServiceGroupUpdateFunc == nil {
+		return nil
+	}
+	return m.ServiceGroupUpdateFunc(name, protocol, members)
+}
+
+// ServiceGroupDelete records call and returns ServiceGroupDeleteFunc result
+func (m *Mock) ServiceGroupDelete(name string) error {
+	m.record("ServiceGroupDelete", name)
+	if m.ServiceGroupDeleteFunc == nil {
+		return nil
+	}
+	return m.ServiceGroupDeleteFunc(name)
+}
+
+// VirtualServerList records call and returns VirtualServerListFunc result
+func (m *Mock) VirtualServerList() []a10go.A10VServer {
+	m.record("VirtualServerList")
+	if m.VirtualServerListFunc == nil {
+		return nil
+	}
+	return m.VirtualServerListFunc()
+}
+
+// ServiceGroupMemberAdd records call and returns ServiceGroupMemberAddFunc result
+func (m *Mock) ServiceGroupMemberAdd(group, member string) error {
+	m.record("ServiceGroupMemberAdd", group, member)
+	if m.ServiceGroupMemberAddFunc == nil {
+		return nil
+	}
+	return m.ServiceGroupMemberAddFunc(group, member)
+}
+
+// ServiceGroupMemberDelete records call and returns ServiceGroupMemberDeleteFunc result
+func (m *Mock) ServiceGroupMemberDelete(group, member string) error {
+	m.record("ServiceGroupMemberDelete", group, member)
+	if m.ServiceGroupMemberDeleteFunc == nil {
+		return nil
+	}
+	return m.ServiceGroupMemberDeleteFunc(group, member)
+}
+
+// VirtualServerCreate records call and returns VirtualServerCreateFunc result
+func (m *Mock) VirtualServerCreate(name, address string, virtualPorts []string) error {
+	m.record("VirtualServerCreate", name, address, virtualPorts)
+	if m.VirtualServerCreateFunc == nil {
+		return nil
+	}
+	return m.VirtualServerCreateFunc(name, address, virtualPorts)
+}
+
+// VirtualServerUpdate records call and returns VirtualServerUpdateFunc result
+func (m *Mock) VirtualServerUpdate(name, address string, virtualPorts []string) error {
+	m.record("VirtualServerUpdate", name, address, virtualPorts)
+	if m.VirtualServerUpdateFunc == nil {
+		return nil
+	}
+	return m.VirtualServerUpdateFunc(name, address, virtualPorts)
+}
+
+// VirtualServerCreateTemplate records call and returns VirtualServerCreateTemplateFunc result
+func (m *Mock) VirtualServerCreateTemplate(name, address, template string, virtualPorts []string) error {
+	m.record("VirtualServerCreateTemplate", name, address, template, virtualPorts)
+	if m.VirtualServerCreateTemplateFunc == nil {
+		return nil
+	}
+	return m.VirtualServerCreateTemplateFunc(name, address, template, virtualPorts)
+}
+
+// VirtualServerUpdateTemplate records call and returns VirtualServerUpdateTemplateFunc result
+func (m *Mock) VirtualServerUpdateTemplate(name, address, template string, virtualPorts []string) error {
+	m.record("VirtualServerUpdateTemplate", name, address, template, virtualPorts)
+	if m.VirtualServerUpdateTemplateFunc == nil {
+		return nil
+	}
+	return m.VirtualServerUpdateTemplateFunc(name, address, template, virtualPorts)
+}
+
+// VirtualServerDelete records call and returns VirtualServerDeleteFunc result
+func (m *Mock) VirtualServerDelete(name string) error {
+	m.record("VirtualServerDelete", name)
+	if m.VirtualServerDeleteFunc == nil {
+		return nil
+	}
+	return m.VirtualServerDeleteFunc(name)
+}
+
+// ServerCreateBatch records call and returns ServerCreateBatchFunc result
+func (m *Mock) ServerCreateBatch(servers []a10go.A10Server, opt a10go.BatchOptions) ([]a10go.BatchResult, error) {
+	m.record("ServerCreateBatch", servers, opt)
+	if m.ServerCreateBatchFunc == nil {
+		return nil, nil
+	}
+	return m.ServerCreateBatchFunc(servers, opt)
+}
+
+// ServerDeleteBatch records call and returns ServerDeleteBatchFunc result
+func (m *Mock) ServerDeleteBatch(names []string, opt a10go.BatchOptions) ([]a10go.BatchResult, error) {
+	m.record("ServerDeleteBatch", names, opt)
+	if m.ServerDeleteBatchFunc == nil {
+		return nil, nil
+	}
+	return m.ServerDeleteBatchFunc(names, opt)
+}
+
+// ServiceGroupMemberAddBatch records call and returns ServiceGroupMemberAddBatchFunc result
+func (m *Mock) ServiceGroupMemberAddBatch(group string, members []string, opt a10go.BatchOptions) ([]a10go.BatchResult, error) {
+	m.record("ServiceGroupMemberAddBatch", group, members, opt)
+	if m.ServiceGroupMemberAddBatchFunc == nil {
+		return nil, nil
+	}
+	return m.ServiceGroupMemberAddBatchFunc(group, members, opt)
+}
+
+// ServerEnsure records call and returns ServerEnsureFunc result
+func (m *Mock) ServerEnsure(name, host string, ports []string) (a10go.EnsureResult, error) {
+	m.record("ServerEnsure", name, host, ports)
+	if m.ServerEnsureFunc == nil {
+		return a10go.EnsureUnchanged, nil
+	}
+	return m.ServerEnsureFunc(name, host, ports)
+}
+
+// ServerEnsureTemplate records call and returns ServerEnsureTemplateFunc result
+func (m *Mock) ServerEnsureTemplate(name, host, template string, ports []string) (a10go.EnsureResult, error) {
+	m.record("ServerEnsureTemplate", name, host, template, ports)
+	if m.ServerEnsureTemplateFunc == nil {
+		return a10go.EnsureUnchanged, nil
+	}
+	return m.ServerEnsureTemplateFunc(name, host, template, ports)
+}
+
+// ServiceGroupEnsure records call and returns ServiceGroupEnsureFunc result
+func (m *Mock) ServiceGroupEnsure(name, protocol string, members []string) (a10go.EnsureResult, error) {
+	m.record("ServiceGroupEnsure", name, protocol, members)
+	if m.ServiceGroupEnsureFunc == nil {
+		return a10go.EnsureUnchanged, nil
+	}
+	return m.ServiceGroupEnsureFunc(name, protocol, members)
+}
+
+// VirtualServerEnsure records call and returns VirtualServerEnsureFunc result
+func (m *Mock) VirtualServerEnsure(name, address string, virtualPorts []string) (a10go.EnsureResult, error) {
+	m.record("VirtualServerEnsure", name, address, virtualPorts)
+	if m.VirtualServerEnsureFunc == nil {
+		return a10go.EnsureUnchanged, nil
+	}
+	return m.VirtualServerEnsureFunc(name, address, virtualPorts)
+}
+
+// VirtualServerEnsureTemplate records call and returns VirtualServerEnsureTemplateFunc result
+func (m *Mock) VirtualServerEnsureTemplate(name, address, template string, virtualPorts []string) (a10go.EnsureResult, error) {
+	m.record("VirtualServerEnsureTemplate", name, address, template, virtualPorts)
+	if m.VirtualServerEnsureTemplateFunc == nil {
+		return a10go.EnsureUnchanged, nil
+	}
+	return m.VirtualServerEnsureTemplateFunc(name, address, template, virtualPorts)
+}
+
+// ServerPatch records call and returns ServerPatchFunc result
+func (m *Mock) ServerPatch(name string, spec a10go.ServerPatchSpec) error {
+	m.record("ServerPatch", name, spec)
+	if m.ServerPatchFunc == nil {
+		return nil
+	}
+	return m.ServerPatchFunc(name, spec)
+}
+
+// ServerReplace records call and returns ServerReplaceFunc result
+func (m *Mock) ServerReplace(name, host string, ports []string) error {
+	m.record("ServerReplace", name, host, ports)
+	if m.ServerReplaceFunc == nil {
+		return nil
+	}
+	return m.ServerReplaceFunc(name, host, ports)
+}
+
+// ServerReplaceTemplate records call and returns ServerReplaceTemplateFunc result
+func (m *Mock) ServerReplaceTemplate(name, host, template string, ports []string) error {
+	m.record("ServerReplaceTemplate", name, host, template, ports)
+	if m.ServerReplaceTemplateFunc == nil {
+		return nil
+	}
+	return m.ServerReplaceTemplateFunc(name, host, template, ports)
+}
+
+// ServiceGroupPatch records call and returns ServiceGroupPatchFunc result
+func (m *Mock) ServiceGroupPatch(name string, spec a10go.ServiceGroupPatchSpec) error {
+	m.record("ServiceGroupPatch", name, spec)
+	if m.ServiceGroupPatchFunc == nil {
+		return nil
+	}
+	return m.ServiceGroupPatchFunc(name, spec)
+}
+
+// ServiceGroupReplace records call and returns ServiceGroupReplaceFunc result
+func (m *Mock) ServiceGroupReplace(name, protocol string, members []string) error {
+	m.record("ServiceGroupReplace", name, protocol, members)
+	if m.ServiceGroupReplaceFunc == nil {
+		return nil
+	}
+	return m.ServiceGroupReplaceFunc(name, protocol, members)
+}
+
+// VirtualServerPatch records call and returns VirtualServerPatchFunc result
+func (m *Mock) VirtualServerPatch(name string, spec a10go.VirtualServerPatchSpec) error {
+	m.record("VirtualServerPatch", name, spec)
+	if m.VirtualServerPatchFunc == nil {
+		return nil
+	}
+	return m.VirtualServerPatchFunc(name, spec)
+}
+
+// VirtualServerReplace records call and returns VirtualServerReplaceFunc result
+func (m *Mock) VirtualServerReplace(name, address string, virtualPorts []string) error {
+	m.record("VirtualServerReplace", name, address, virtualPorts)
+	if m.VirtualServerReplaceFunc == nil {
+		return nil
+	}
+	return m.VirtualServerReplaceFunc(name, address, virtualPorts)
+}
+
+// VirtualServerReplaceTemplate records call and returns VirtualServerReplaceTemplateFunc result
+func (m *Mock) VirtualServerReplaceTemplate(name, address, template string, virtualPorts []string) error {
+	m.record("VirtualServerReplaceTemplate", name, address, template, virtualPorts)
+	if m.VirtualServerReplaceTemplateFunc == nil {
+		return nil
+	}
+	return m.VirtualServerReplaceTemplateFunc(name, address, template, virtualPorts)
+}
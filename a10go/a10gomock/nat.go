@@ -0,0 +1,77 @@
+package a10gomock
+
+import (
+	"github.com/udhos/a10-go-rest-client/a10go"
+)
+
+// NATPoolList records call and returns NATPoolListFunc result
+func (m *Mock) NATPoolList() []a10go.A10NATPool {
+	m.record("NATPoolList")
+	if m.NATPoolListFunc == nil {
+		return nil
+	}
+	return m.NATPoolListFunc()
+}
+
+// NATPoolCreate records call and returns NATPoolCreateFunc result
+func (m *Mock) NATPoolCreate(p a10go.A10NATPool) error {
+	m.record("NATPoolCreate", p)
+	if m.NATPoolCreateFunc == nil {
+		return nil
+	}
+	return m.NATPoolCreateFunc(p)
+}
+
+// NATPoolUpdate records call and returns NATPoolUpdateFunc result
+func (m *Mock) NATPoolUpdate(p a10go.A10NATPool) error {
+	m.record("NATPoolUpdate", p)
+	if m.NATPoolUpdateFunc == nil {
+		return nil
+	}
+	return m.NATPoolUpdateFunc(p)
+}
+
+// NATPoolDelete records call and returns NATPoolDeleteFunc result
+func (m *Mock) NATPoolDelete(name string) error {
+	m.record("NATPoolDelete", name)
+	if m.NATPoolDeleteFunc == nil {
+		return nil
+	}
+	return m.NATPoolDeleteFunc(name)
+}
+
+// NATPoolGroupList records call and returns NATPoolGroupListFunc result
+func (m *Mock) NATPoolGroupList() []a10go.A10NATPoolGroup {
+	m.record("NATPoolGroupList")
+	if m.NATPoolGroupListFunc == nil {
+		return nil
+	}
+	return m.NATPoolGroupListFunc()
+}
+
+// NATPoolGroupCreate records call and returns NATPoolGroupCreateFunc result
+func (m *Mock) NATPoolGroupCreate(g a10go.A10NATPoolGroup) error {
+	m.record("NATPoolGroupCreate", g)
+	if m.NATPoolGroupCreateFunc == nil {
+		return nil
+	}
+	return m.NATPoolGroupCreateFunc(g)
+}
+
+// NATPoolGroupUpdate records call and returns NATPoolGroupUpdateFunc result
+func (m *Mock) NATPoolGroupUpdate(g a10go.A10NATPoolGroup) error {
+	m.record("NATPoolGroupUpdate", g)
+	if m.NATPoolGroupUpdateFunc == nil {
+		return nil
+	}
+	return m.NATPoolGroupUpdateFunc(g)
+}
+
+// NATPoolGroupDelete records call and returns NATPoolGroupDeleteFunc result
+func (m *Mock) NATPoolGroupDelete(name string) error {
+	m.record("NATPoolGroupDelete", name)
+	if m.NATPoolGroupDeleteFunc == nil {
+		return nil
+	}
+	return m.NATPoolGroupDeleteFunc(name)
+}
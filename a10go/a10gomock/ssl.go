@@ -0,0 +1,61 @@
+package a10gomock
+
+import (
+	"time"
+
+	"github.com/udhos/a10-go-rest-client/a10go"
+)
+
+// SSLCertList records call and returns SSLCertListFunc result
+func (m *Mock) SSLCertList() []a10go.A10SSLCert {
+	m.record("SSLCertList")
+	if m.SSLCertListFunc == nil {
+		return nil
+	}
+	return m.SSLCertListFunc()
+}
+
+// SSLCertGet records call and returns SSLCertGetFunc result
+func (m *Mock) SSLCertGet(name string) (a10go.A10SSLCert, error) {
+	m.record("SSLCertGet", name)
+	if m.SSLCertGetFunc == nil {
+		return a10go.A10SSLCert{}, nil
+	}
+	return m.SSLCertGetFunc(name)
+}
+
+// SSLCertUpload records call and returns SSLCertUploadFunc result
+func (m *Mock) SSLCertUpload(name string, certPEM, keyPEM []byte, passphrase string) (a10go.A10SSLCert, error) {
+	m.record("SSLCertUpload", name, certPEM, keyPEM, passphrase)
+	if m.SSLCertUploadFunc == nil {
+		return a10go.A10SSLCert{}, nil
+	}
+	return m.SSLCertUploadFunc(name, certPEM, keyPEM, passphrase)
+}
+
+// SSLCertDelete records call and returns SSLCertDeleteFunc result
+func (m *Mock) SSLCertDelete(name string) error {
+	m.record("SSLCertDelete", name)
+	if m.SSLCertDeleteFunc == nil {
+		return nil
+	}
+	return m.SSLCertDeleteFunc(name)
+}
+
+// CertExpiring records call and returns CertExpiringFunc result
+func (m *Mock) CertExpiring(within time.Duration) ([]a10go.CertExpiry, error) {
+	m.record("CertExpiring", within)
+	if m.CertExpiringFunc == nil {
+		return nil, nil
+	}
+	return m.CertExpiringFunc(within)
+}
+
+// CertRotate records call and returns CertRotateFunc result
+func (m *Mock) CertRotate(template, name string, certPEM, keyPEM []byte, passphrase string) (a10go.A10SSLCert, error) {
+	m.record("CertRotate", template, name, certPEM, keyPEM, passphrase)
+	if m.CertRotateFunc == nil {
+		return a10go.A10SSLCert{}, nil
+	}
+	return m.CertRotateFunc(template, name, certPEM, keyPEM, passphrase)
+}
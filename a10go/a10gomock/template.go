@@ -0,0 +1,473 @@
+package a10gomock
+
+import (
+	"github.com/udhos/a10-go-rest-client/a10go"
+)
+
+// CookiePersistenceTemplateList records call and returns CookiePersistenceTemplateListFunc result
+func (m *Mock) CookiePersistenceTemplateList() []a10go.A10CookiePersistenceTemplate {
+	m.record("CookiePersistenceTemplateList")
+	if m.CookiePersistenceTemplateListFunc == nil {
+		return nil
+	}
+	return m.CookiePersistenceTemplateListFunc()
+}
+
+// CookiePersistenceTemplateCreate records call and returns CookiePersistenceTemplateCreateFunc result
+func (m *Mock) CookiePersistenceTemplateCreate(t a10go.A10CookiePersistenceTemplate) error {
+	m.record("CookiePersistenceTemplateCreate", t)
+	if m.CookiePersistenceTemplateCreateFunc == nil {
+		return nil
+	}
+	return m.CookiePersistenceTemplateCreateFunc(t)
+}
+
+// CookiePersistenceTemplateUpdate records call and returns CookiePersistenceTemplateUpdateFunc result
+func (m *Mock) CookiePersistenceTemplateUpdate(t a10go.A10CookiePersistenceTemplate) error {
+	m.record("CookiePersistenceTemplateUpdate", t)
+	if m.CookiePersistenceTemplateUpdateFunc == nil {
+		return nil
+	}
+	return m.CookiePersistenceTemplateUpdateFunc(t)
+}
+
+// CookiePersistenceTemplateDelete records call and returns CookiePersistenceTemplateDeleteFunc result
+func (m *Mock) CookiePersistenceTemplateDelete(name string) error {
+	m.record("CookiePersistenceTemplateDelete", name)
+	if m.CookiePersistenceTemplateDeleteFunc == nil {
+		return nil
+	}
+	return m.CookiePersistenceTemplateDeleteFunc(name)
+}
+
+// SrcIPPersistenceTemplateList records call and returns SrcIPPersistenceTemplateListFunc result
+func (m *Mock) SrcIPPersistenceTemplateList() []a10go.A10SrcIPPersistenceTemplate {
+	m.record("SrcIPPersistenceTemplateList")
+	if m.SrcIPPersistenceTemplateListFunc == nil {
+		return nil
+	}
+	return m.SrcIPPersistenceTemplateListFunc()
+}
+
+// SrcIPPersistenceTemplateCreate records call and returns SrcIPPersistenceTemplateCreateFunc result
+func (m *Mock) SrcIPPersistenceTemplateCreate(t a10go.A10SrcIPPersistenceTemplate) error {
+	m.record("SrcIPPersistenceTemplateCreate", t)
+	if m.SrcIPPersistenceTemplateCreateFunc == nil {
+		return nil
+	}
+	return m.SrcIPPersistenceTemplateCreateFunc(t)
+}
+
+// SrcIPPersistenceTemplateUpdate records call and returns SrcIPPersistenceTemplateUpdateFunc result
+func (m *Mock) SrcIPPersistenceTemplateUpdate(t a10go.A10SrcIPPersistenceTemplate) error {
+	m.record("SrcIPPersistenceTemplateUpdate", t)
+	if m.SrcIPPersistenceTemplateUpdateFunc == nil {
+		return nil
+	}
+	return m.SrcIPPersistenceTemplateUpdateFunc(t)
+}
+
+// SrcIPPersistenceTemplateDelete records call and returns SrcIPPersistenceTemplateDeleteFunc result
+func (m *Mock) SrcIPPersistenceTemplateDelete(name string) error {
+	m.record("SrcIPPersistenceTemplateDelete", name)
+	if m.SrcIPPersistenceTemplateDeleteFunc == nil {
+		return nil
+	}
+	return m.SrcIPPersistenceTemplateDeleteFunc(name)
+}
+
+// DstIPPersistenceTemplateList records call and returns DstIPPersistenceTemplateListFunc result
+func (m *Mock) DstIPPersistenceTemplateList() []a10go.A10DstIPPersistenceTemplate {
+	m.record("DstIPPersistenceTemplateList")
+	if m.DstIPPersistenceTemplateListFunc == nil {
+		return nil
+	}
+	return m.DstIPPersistenceTemplateListFunc()
+}
+
+// DstIPPersistenceTemplateCreate records call and returns DstIPPersistenceTemplateCreateFunc result
+func (m *Mock) DstIPPersistenceTemplateCreate(t a10go.A10DstIPPersistenceTemplate) error {
+	m.record("DstIPPersistenceTemplateCreate", t)
+	if m.DstIPPersistenceTemplateCreateFunc == nil {
+		return nil
+	}
+	return m.DstIPPersistenceTemplateCreateFunc(t)
+}
+
+// DstIPPersistenceTemplateUpdate records call and returns DstIPPersistenceTemplateUpdateFunc result
+func (m *Mock) DstIPPersistenceTemplateUpdate(t a10go.A10DstIPPersistenceTemplate) error {
+	m.record("DstIPPersistenceTemplateUpdate", t)
+	if m.DstIPPersistenceTemplateUpdateFunc == nil {
+		return nil
+	}
+	return m.DstIPPersistenceTemplateUpdateFunc(t)
+}
+
+// DstIPPersistenceTemplateDelete records call and returns DstIPPersistenceTemplateDeleteFunc result
+func (m *Mock) DstIPPersistenceTemplateDelete(name string) error {
+	m.record("DstIPPersistenceTemplateDelete", name)
+	if m.DstIPPersistenceTemplateDeleteFunc == nil {
+		return nil
+	}
+	return m.DstIPPersistenceTemplateDeleteFunc(name)
+}
+
+// ClientSSLTemplateList records call and returns ClientSSLTemplateListFunc result
+func (m *Mock) ClientSSLTemplateList() []a10go.A10ClientSSLTemplate {
+	m.record("ClientSSLTemplateList")
+	if m.ClientSSLTemplateListFunc == nil {
+		return nil
+	}
+	return m.ClientSSLTemplateListFunc()
+}
+
+// ClientSSLTemplateCreate records call and returns ClientSSLTemplateCreateFunc result
+func (m *Mock) ClientSSLTemplateCreate(t a10go.A10ClientSSLTemplate) error {
+	m.record("ClientSSLTemplateCreate", t)
+	if m.ClientSSLTemplateCreateFunc == nil {
+		return nil
+	}
+	return m.ClientSSLTemplateCreateFunc(t)
+}
+
+// ClientSSLTemplateUpdate records call and returns ClientSSLTemplateUpdateFunc result
+func (m *Mock) ClientSSLTemplateUpdate(t a10go.A10ClientSSLTemplate) error {
+	m.record("ClientSSLTemplateUpdate", t)
+	if m.ClientSSLTemplateUpdateFunc == nil {
+		return nil
+	}
+	return m.ClientSSLTemplateUpdateFunc(t)
+}
+
+// ClientSSLTemplateDelete records call and returns ClientSSLTemplateDeleteFunc result
+func (m *Mock) ClientSSLTemplateDelete(name string) error {
+	m.record("ClientSSLTemplateDelete", name)
+	if m.ClientSSLTemplateDeleteFunc == nil {
+		return nil
+	}
+	return m.ClientSSLTemplateDeleteFunc(name)
+}
+
+// ServerSSLTemplateList records call and returns ServerSSLTemplateListFunc result
+func (m *Mock) ServerSSLTemplateList() []a10go.A10ServerSSLTemplate {
+	m.record("ServerSSLTemplateList")
+	if m.ServerSSLTemplateListFunc == nil {
+		return nil
+	}
+	return m.ServerSSLTemplateListFunc()
+}
+
+// ServerSSLTemplateCreate records call and returns ServerSSLTemplateCreateFunc result
+func (m *Mock) ServerSSLTemplateCreate(t a10go.A10ServerSSLTemplate) error {
+	m.record("ServerSSLTemplateCreate", t)
+	if m.ServerSSLTemplateCreateFunc == nil {
+		return nil
+	}
+	return m.ServerSSLTemplateCreateFunc(t)
+}
+
+// ServerSSLTemplateUpdate records call and returns ServerSSLTemplateUpdateFunc result
+func (m *Mock) ServerSSLTemplateUpdate(t a10go.A10ServerSSLTemplate) error {
+	m.record("ServerSSLTemplateUpdate", t)
+	if m.ServerSSLTemplateUpdateFunc == nil {
+		return nil
+	}
+	return m.ServerSSLTemplateUpdateFunc(t)
+}
+
+// ServerSSLTemplateDelete records call and returns ServerSSLTemplateDeleteFunc result
+func (m *Mock) ServerSSLTemplateDelete(name string) error {
+	m.record("ServerSSLTemplateDelete", name)
+	if m.ServerSSLTemplateDeleteFunc == nil {
+		return nil
+	}
+	return m.ServerSSLTemplateDeleteFunc(name)
+}
+
+// HTTPTemplateList records call and returns HTTPTemplateListFunc result
+func (m *Mock) HTTPTemplateList() []a10go.A10HTTPTemplate {
+	m.record("HTTPTemplateList")
+	if m.HTTPTemplateListFunc == nil {
+		return nil
+	}
+	return m.HTTPTemplateListFunc()
+}
+
+// HTTPTemplateCreate records call and returns HTTPTemplateCreateFunc result
+func (m *Mock) HTTPTemplateCreate(t a10go.A10HTTPTemplate) error {
+	m.record("HTTPTemplateCreate", t)
+	if m.HTTPTemplateCreateFunc == nil {
+		return nil
+	}
+	return m.HTTPTemplateCreateFunc(t)
+}
+
+// HTTPTemplateUpdate records call and returns HTTPTemplateUpdateFunc result
+func (m *Mock) HTTPTemplateUpdate(t a10go.A10HTTPTemplate) error {
+	m.record("HTTPTemplateUpdate", t)
+	if m.HTTPTemplateUpdateFunc == nil {
+		return nil
+	}
+	return m.HTTPTemplateUpdateFunc(t)
+}
+
+// HTTPTemplateDelete records call and returns HTTPTemplateDeleteFunc result
+func (m *Mock) HTTPTemplateDelete(name string) error {
+	m.record("HTTPTemplateDelete", name)
+	if m.HTTPTemplateDeleteFunc == nil {
+		return nil
+	}
+	return m.HTTPTemplateDeleteFunc(name)
+}
+
+// TCPTemplateList records call and returns TCPTemplateListFunc result
+func (m *Mock) TCPTemplateList() []a10go.A10TCPTemplate {
+	m.record("TCPTemplateList")
+	if m.TCPTemplateListFunc == nil {
+		return nil
+	}
+	return m.TCPTemplateListFunc()
+}
+
+// TCPTemplateCreate records call and returns TCPTemplateCreateFunc result
+func (m *Mock) TCPTemplateCreate(t a10go.A10TCPTemplate) error {
+	m.record("TCPTemplateCreate", t)
+	if m.TCPTemplateCreateFunc == nil {
+		return nil
+	}
+	return m.TCPTemplateCreateFunc(t)
+}
+
+// TCPTemplateUpdate records call and returns TCPTemplateUpdateFunc result
+func (m *Mock) TCPTemplateUpdate(t a10go.A10TCPTemplate) error {
+	m.record("TCPTemplateUpdate", t)
+	if m.TCPTemplateUpdateFunc == nil {
+		return nil
+	}
+	return m.TCPTemplateUpdateFunc(t)
+}
+
+// TCPTemplateDelete records call and returns TCPTemplateDeleteFunc result
+func (m *Mock) TCPTemplateDelete(name string) error {
+	m.record("TCPTemplateDelete", name)
+	if m.TCPTemplateDeleteFunc == nil {
+		return nil
+	}
+	return m.TCPTemplateDeleteFunc(name)
+}
+
+// UDPTemplateList records call and returns UDPTemplateListFunc result
+func (m *Mock) UDPTemplateList() []a10go.A10UDPTemplate {
+	m.record("UDPTemplateList")
+	if m.UDPTemplateListFunc == nil {
+		return nil
+	}
+	return m.UDPTemplateListFunc()
+}
+
+// UDPTemplateCreate records call and returns UDPTemplateCreateFunc result
+func (m *Mock) UDPTemplateCreate(t a10go.A10UDPTemplate) error {
+	m.record("UDPTemplateCreate", t)
+	if m.UDPTemplateCreateFunc == nil {
+		return nil
+	}
+	return m.UDPTemplateCreateFunc(t)
+}
+
+// UDPTemplateUpdate records call and returns UDPTemplateUpdateFunc result
+func (m *Mock) UDPTemplateUpdate(t a10go.A10UDPTemplate) error {
+	m.record("UDPTemplateUpdate", t)
+	if m.UDPTemplateUpdateFunc == nil {
+		return nil
+	}
+	return m.UDPTemplateUpdateFunc(t)
+}
+
+// UDPTemplateDelete records call and returns UDPTemplateDeleteFunc result
+func (m *Mock) UDPTemplateDelete(name string) error {
+	m.record("UDPTemplateDelete", name)
+	if m.UDPTemplateDeleteFunc == nil {
+		return nil
+	}
+	return m.UDPTemplateDeleteFunc(name)
+}
+
+// ConnReuseTemplateList records call and returns ConnReuseTemplateListFunc result
+func (m *Mock) ConnReuseTemplateList() []a10go.A10ConnReuseTemplate {
+	m.record("ConnReuseTemplateList")
+	if m.ConnReuseTemplateListFunc == nil {
+		return nil
+	}
+	return m.ConnReuseTemplateListFunc()
+}
+
+// ConnReuseTemplateCreate records call and returns ConnReuseTemplateCreateFunc result
+func (m *Mock) ConnReuseTemplateCreate(t a10go.A10ConnReuseTemplate) error {
+	m.record("ConnReuseTemplateCreate", t)
+	if m.ConnReuseTemplateCreateFunc == nil {
+		return nil
+	}
+	return m.ConnReuseTemplateCreateFunc(t)
+}
+
+// ConnReuseTemplateUpdate records call and returns ConnReuseTemplateUpdateFunc result
+func (m *Mock) ConnReuseTemplateUpdate(t a10go.A10ConnReuseTemplate) error {
+	m.record("ConnReuseTemplateUpdate", t)
+	if m.ConnReuseTemplateUpdateFunc == nil {
+		return nil
+	}
+	return m.ConnReuseTemplateUpdateFunc(t)
+}
+
+// ConnReuseTemplateDelete records call and returns ConnReuseTemplateDeleteFunc result
+func (m *Mock) ConnReuseTemplateDelete(name string) error {
+	m.record("ConnReuseTemplateDelete", name)
+	if m.ConnReuseTemplateDeleteFunc == nil {
+		return nil
+	}
+	return m.ConnReuseTemplateDeleteFunc(name)
+}
+
+// ServerTemplateList records call and returns ServerTemplateListFunc result
+func (m *Mock) ServerTemplateList() []a10go.A10ServerTemplate {
+	m.record("ServerTemplateList")
+	if m.ServerTemplateListFunc == nil {
+		return nil
+	}
+	return m.ServerTemplateListFunc()
+}
+
+// ServerTemplateCreate records call and returns ServerTemplateCreateFunc result
+func (m *Mock) ServerTemplateCreate(t a10go.A10ServerTemplate) error {
+	m.record("ServerTemplateCreate", t)
+	if m.ServerTemplateCreateFunc == nil {
+		return nil
+	}
+	return m.ServerTemplateCreateFunc(t)
+}
+
+// ServerTemplateUpdate records call and returns ServerTemplateUpdateFunc result
+func (m *Mock) ServerTemplateUpdate(t a10go.A10ServerTemplate) error {
+	m.record("ServerTemplateUpdate", t)
+	if m.ServerTemplateUpdateFunc == nil {
+		return nil
+	}
+	return m.ServerTemplateUpdateFunc(t)
+}
+
+// ServerTemplateDelete records call and returns ServerTemplateDeleteFunc result
+func (m *Mock) ServerTemplateDelete(name string) error {
+	m.record("ServerTemplateDelete", name)
+	if m.ServerTemplateDeleteFunc == nil {
+		return nil
+	}
+	return m.ServerTemplateDeleteFunc(name)
+}
+
+// PortTemplateList records call and returns PortTemplateListFunc result
+func (m *Mock) PortTemplateList() []a10go.A10PortTemplate {
+	m.record("PortTemplateList")
+	if m.PortTemplateListFunc == nil {
+		return nil
+	}
+	return m.PortTemplateListFunc()
+}
+
+// PortTemplateCreate records call and returns PortTemplateCreateFunc result
+func (m *Mock) PortTemplateCreate(t a10go.A10PortTemplate) error {
+	m.record("PortTemplateCreate", t)
+	if m.PortTemplateCreateFunc == nil {
+		return nil
+	}
+	return m.PortTemplateCreateFunc(t)
+}
+
+// PortTemplateUpdate records call and returns PortTemplateUpdateFunc result
+func (m *Mock) PortTemplateUpdate(t a10go.A10PortTemplate) error {
+	m.record("PortTemplateUpdate", t)
+	if m.PortTemplateUpdateFunc == nil {
+		return nil
+	}
+	return m.PortTemplateUpdateFunc(t)
+}
+
+// PortTemplateDelete records call and returns PortTemplateDeleteFunc result
+func (m *Mock) PortTemplateDelete(name string) error {
+	m.record("PortTemplateDelete", name)
+	if m.PortTemplateDeleteFunc == nil {
+		return nil
+	}
+	return m.PortTemplateDeleteFunc(name)
+}
+
+// VirtualServerTemplateList records call and returns VirtualServerTemplateListFunc result
+func (m *Mock) VirtualServerTemplateList() []a10go.A10VirtualServerTemplate {
+	m.record("VirtualServerTemplateList")
+	if m.VirtualServerTemplateListFunc == nil {
+		return nil
+	}
+	return m.VirtualServerTemplateListFunc()
+}
+
+// VirtualServerTemplateCreate records call and returns VirtualServerTemplateCreateFunc result
+func (m *Mock) VirtualServerTemplateCreate(t a10go.A10VirtualServerTemplate) error {
+	m.record("VirtualServerTemplateCreate", t)
+	if m.VirtualServerTemplateCreateFunc == nil {
+		return nil
+	}
+	return m.VirtualServerTemplateCreateFunc(t)
+}
+
+// VirtualServerTemplateUpdate records call and returns VirtualServerTemplateUpdateFunc result
+func (m *Mock) VirtualServerTemplateUpdate(t a10go.A10VirtualServerTemplate) error {
+	m.record("VirtualServerTemplateUpdate", t)
+	if m.VirtualServerTemplateUpdateFunc == nil {
+		return nil
+	}
+	return m.VirtualServerTemplateUpdateFunc(t)
+}
+
+// VirtualServerTemplateDelete records call and returns VirtualServerTemplateDeleteFunc result
+func (m *Mock) VirtualServerTemplateDelete(name string) error {
+	m.record("VirtualServerTemplateDelete", name)
+	if m.VirtualServerTemplateDeleteFunc == nil {
+		return nil
+	}
+	return m.VirtualServerTemplateDeleteFunc(name)
+}
+
+// VirtualPortTemplateList records call and returns VirtualPortTemplateListFunc result
+func (m *Mock) VirtualPortTemplateList() []a10go.A10VirtualPortTemplate {
+	m.record("VirtualPortTemplateList")
+	if m.VirtualPortTemplateListFunc == nil {
+		return nil
+	}
+	return m.VirtualPortTemplateListFunc()
+}
+
+// VirtualPortTemplateCreate records call and returns VirtualPortTemplateCreateFunc result
+func (m *Mock) VirtualPortTemplateCreate(t a10go.A10VirtualPortTemplate) error {
+	m.record("VirtualPortTemplateCreate", t)
+	if m.VirtualPortTemplateCreateFunc == nil {
+		return nil
+	}
+	return m.VirtualPortTemplateCreateFunc(t)
+}
+
+// VirtualPortTemplateUpdate records call and returns VirtualPortTemplateUpdateFunc result
+func (m *Mock) VirtualPortTemplateUpdate(t a10go.A10VirtualPortTemplate) error {
+	m.record("VirtualPortTemplateUpdate", t)
+	if m.VirtualPortTemplateUpdateFunc == nil {
+		return nil
+	}
+	return m.VirtualPortTemplateUpdateFunc(t)
+}
+
+// VirtualPortTemplateDelete records call and returns VirtualPortTemplateDeleteFunc result
+func (m *Mock) VirtualPortTemplateDelete(name string) error {
+	m.record("VirtualPortTemplateDelete", name)
+	if m.VirtualPortTemplateDeleteFunc == nil {
+		return nil
+	}
+	return m.VirtualPortTemplateDeleteFunc(name)
+}
@@ -0,0 +1,82 @@
+package a10gomock
+
+import (
+	"github.com/udhos/a10-go-rest-client/a10go"
+)
+
+// Begin records call and returns BeginFunc result.
+// If BeginFunc is nil, Begin returns a new *Tx.
+func (m *Mock) Begin() a10go.Transaction {
+	m.record("Begin")
+	if m.BeginFunc == nil {
+		return &Tx{m: m}
+	}
+	return m.BeginFunc()
+}
+
+// Tx is an a10go.Transaction implementation for testing.
+// Tx records steps as calls to the parent Mock, named "Tx.<method>",
+// and calls Mock.TxCommitFunc on Commit.
+type Tx struct {
+	m     *Mock
+	Steps []Call // steps added to the transaction
+}
+
+func (tx *Tx) add(method string, args ...interface{}) {
+	tx.m.record("Tx."+method, args...)
+	tx.Steps = append(tx.Steps, Call{Method: method, Args: args})
+}
+
+// ServerCreate records step
+func (tx *Tx) ServerCreate(name, host string, ports []string) {
+	tx.add("ServerCreate", name, host, ports)
+}
+
+// ServerUpdate records step
+func (tx *Tx) ServerUpdate(name, host string, ports []string) {
+	tx.add("ServerUpdate", name, host, ports)
+}
+
+// ServerDelete records step
+func (tx *Tx) ServerDelete(name string) {
+	tx.add("ServerDelete", name)
+}
+
+// ServiceGroupCreate records step
+func (tx *Tx) ServiceGroupCreate(name, protocol string, members []string) {
+	tx.add("ServiceGroupCreate", name, protocol, members)
+}
+
+// ServiceGroupUpdate records step
+func (tx *Tx) ServiceGroupUpdate(name, protocol string, members []string) {
+	tx.add("ServiceGroupUpdate", name, protocol, members)
+}
+
+// ServiceGroupDelete records step
+func (tx *Tx) ServiceGroupDelete(name string) {
+	tx.add("ServiceGroupDelete", name)
+}
+
+// VirtualServerCreate records step
+func (tx *Tx) VirtualServerCreate(name, address string, virtualPorts []string) {
+	tx.add("VirtualServerCreate", name, address, virtualPorts)
+}
+
+// VirtualServerUpdate records step
+func (tx *Tx) VirtualServerUpdate(name, address string, virtualPorts []string) {
+	tx.add("VirtualServerUpdate", name, address, virtualPorts)
+}
+
+// VirtualServerDelete records step
+func (tx *Tx) VirtualServerDelete(name string) {
+	tx.add("VirtualServerDelete", name)
+}
+
+// Commit records call and returns Mock.TxCommitFunc result
+func (tx *Tx) Commit() error {
+	tx.m.record("Tx.Commit")
+	if tx.m.TxCommitFunc == nil {
+		return nil
+	}
+	return tx.m.TxCommitFunc(tx)
+}
@@ -0,0 +1,90 @@
+package a10go
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+// aXAPI v2.1 keeps IPv6 addresses in separate fields:
+//
+//	server:         "host" (IPv4 address or hostname) or "host_ipv6"
+//	virtual server: "address" and/or "address_ipv6"
+//
+// Servers take a single host. Virtual servers take "address" as "ipv4",
+// "ipv6" or, for dual-stack virtual servers, "ipv4,ipv6".
+
+// isIPv6 reports whether addr is an IPv6 address
+func isIPv6(addr string) bool {
+	ip := net.ParseIP(addr)
+	return ip != nil && ip.To4() == nil
+}
+
+// hostFormat renders server host as aXAPI json field
+func hostFormat(host string) string {
+	if isIPv6(host) {
+		return fmt.Sprintf(`"host_ipv6": "%s"`, host)
+	}
+	return fmt.Sprintf(`"host": "%s"`, host)
+}
+
+// splitAddress splits virtual server address "ipv4[,ipv6]" into its IPv4 and IPv6 parts
+func splitAddress(address string) (string, string) {
+	var v4, v6 string
+	for _, a := range strings.FieldsFunc(address, isSep) {
+		if isIPv6(a) {
+			v6 = a
+			continue
+		}
+		v4 = a
+	}
+	return v4, v6
+}
+
+// joinAddress renders virtual server address as "ipv4[,ipv6]", the form accepted by VirtualServerCreate
+func joinAddress(v4, v6 string) string {
+	switch {
+	case v4 == "":
+		return v6
+	case v6 == "":
+		return v4
+	}
+	return v4 + "," + v6
+}
+
+// addressFormat renders virtual server address as aXAPI json fields
+func addressFormat(address string) string {
+	v4, v6 := splitAddress(address)
+	var fields []string
+	if v4 != "" || v6 == "" {
+		fields = append(fields, fmt.Sprintf(`"address": "%s"`, v4))
+	}
+	if v6 != "" {
+		fields = append(fields, fmt.Sprintf(`"address_ipv6": "%s"`, v6))
+	}
+	return strings.Join(fields, ", ")
+}
+
+// sameAddress compares addresses, ignoring IPv6 notation differences like "2001:DB8::1" vs "2001:db8:0::1"
+func sameAddress(a, b string) bool {
+	a4, a6 := splitAddress(a)
+	b4, b6 := splitAddress(b)
+	return sameIP(a4, b4) && sameIP(a6, b6)
+}
+
+func sameIP(a, b string) bool {
+	ipA, ipB := net.ParseIP(a), net.ParseIP(b)
+	if ipA == nil || ipB == nil {
+		return a == b // hostnames or empty
+	}
+	return ipA.Equal(ipB)
+}
+
+// parseAddr parses address from aXAPI, ignoring unset (unspecified) addresses
+func parseAddr(addr string) (string, net.IP) {
+	ip := net.ParseIP(addr)
+	if ip != nil && ip.IsUnspecified() {
+		return "", nil
+	}
+	return addr, ip
+}
@@ -0,0 +1,137 @@
+package a10go
+
+import (
+	"net"
+	"testing"
+)
+
+func TestHostFormat(t *testing.T) {
+	table := []struct {
+		host     string
+		expected string
+	}{
+		{"10.0.0.1", `"host": "10.0.0.1"`},
+		{"2001:db8::1", `"host_ipv6": "2001:db8::1"`},
+		{"server.example.com", `"host": "server.example.com"`},
+		{"::ffff:10.0.0.1", `"host": "::ffff:10.0.0.1"`}, // IPv4-mapped
+	}
+	for _, data := range table {
+		if got := hostFormat(data.host); got != data.expected {
+			t.Errorf("hostFormat(%q): expected [%s] got [%s]", data.host, data.expected, got)
+		}
+	}
+}
+
+func TestAddressFormat(t *testing.T) {
+	table := []struct {
+		address  string
+		v4, v6   string
+		expected string
+	}{
+		{"10.0.0.100", "10.0.0.100", "", `"address": "10.0.0.100"`},
+		{"2001:db8::100", "", "2001:db8::100", `"address_ipv6": "2001:db8::100"`},
+		{"10.0.0.100,2001:db8::100", "10.0.0.100", "2001:db8::100", `"address": "10.0.0.100", "address_ipv6": "2001:db8::100"`},
+		{"2001:db8::100,10.0.0.100", "10.0.0.100", "2001:db8::100", `"address": "10.0.0.100", "address_ipv6": "2001:db8::100"`},
+		{"", "", "", `"address": ""`},
+	}
+	for _, data := range table {
+		v4, v6 := splitAddress(data.address)
+		if v4 != data.v4 || v6 != data.v6 {
+			t.Errorf("splitAddress(%q): expected [%s] [%s] got [%s] [%s]", data.address, data.v4, data.v6, v4, v6)
+		}
+		if got := addressFormat(data.address); got != data.expected {
+			t.Errorf("addressFormat(%q): expected [%s] got [%s]", data.address, data.expected, got)
+		}
+	}
+}
+
+func TestSameAddress(t *testing.T) {
+	table := []struct {
+		a, b string
+		same bool
+	}{
+		{"10.0.0.1", "10.0.0.1", true},
+		{"10.0.0.1", "10.0.0.2", false},
+		{"2001:DB8::1", "2001:db8:0::1", true},
+		{"10.0.0.1,2001:db8::1", "2001:db8:0:0::1,10.0.0.1", true},
+		{"10.0.0.1,2001:db8::1", "10.0.0.1", false},
+		{"2001:db8::1", "10.0.0.1,2001:db8::1", false},
+		{"server.example.com", "server.example.com", true},
+		{"", "", true},
+	}
+	for _, data := range table {
+		if got := sameAddress(data.a, data.b); got != data.same {
+			t.Errorf("sameAddress(%q, %q): expected %v got %v", data.a, data.b, data.same, got)
+		}
+	}
+}
+
+func TestParseAddr(t *testing.T) {
+	table := []struct {
+		addr     string
+		expected string
+		ip       net.IP
+	}{
+		{"10.0.0.1", "10.0.0.1", net.ParseIP("10.0.0.1")},
+		{"2001:db8::1", "2001:db8::1", net.ParseIP("2001:db8::1")},
+		{"0.0.0.0", "", nil},
+		{"::", "", nil},
+		{"server.example.com", "server.example.com", nil},
+		{"", "", nil},
+	}
+	for _, data := range table {
+		addr, ip := parseAddr(data.addr)
+		if addr != data.expected || !ip.Equal(data.ip) {
+			t.Errorf("parseAddr(%q): expected [%s] %v got [%s] %v", data.addr, data.expected, data.ip, addr, ip)
+		}
+	}
+}
+
+func TestListAddress(t *testing.T) {
+	c, d, done := newFakeClient(t)
+	defer done()
+
+	d.reply("slb.server.getAll", `{"server_list": [
+		{"name": "v4", "host": "10.0.0.1", "host_ipv6": "::"},
+		{"name": "v6", "host": "0.0.0.0", "host_ipv6": "2001:db8::1"},
+		{"name": "v6only", "host_ipv6": "2001:db8::2"}
+	]}`)
+	d.reply("slb.virtual_server.getAll", `{"virtual_server_list": [
+		{"name": "v4", "address": "10.0.0.100", "address_ipv6": "::"},
+		{"name": "v6", "address": "0.0.0.0", "address_ipv6": "2001:db8::100"},
+		{"name": "dual", "address": "10.0.0.101", "address_ipv6": "2001:db8::101"}
+	]}`)
+
+	servers, errServers := a10ServerList(c)
+	if errServers != nil {
+		t.Fatalf("server list: %v", errServers)
+	}
+	expectedServers := map[string]string{"v4": "10.0.0.1", "v6": "2001:db8::1", "v6only": "2001:db8::2"}
+	if len(servers) != len(expectedServers) {
+		t.Fatalf("expected %d servers, got %v", len(expectedServers), servers)
+	}
+	for _, s := range servers {
+		if s.Host != expectedServers[s.Name] || !s.IP.Equal(net.ParseIP(expectedServers[s.Name])) {
+			t.Errorf("server %s: expected host=%s got host=%s ip=%v", s.Name, expectedServers[s.Name], s.Host, s.IP)
+		}
+	}
+
+	vServers, errVS := a10VirtualServerList(c)
+	if errVS != nil {
+		t.Fatalf("virtual server list: %v", errVS)
+	}
+	expectedVS := map[string]struct{ address, v4, v6 string }{
+		"v4":   {"10.0.0.100", "10.0.0.100", ""},
+		"v6":   {"2001:db8::100", "", "2001:db8::100"},
+		"dual": {"10.0.0.101,2001:db8::101", "10.0.0.101", "2001:db8::101"},
+	}
+	if len(vServers) != len(expectedVS) {
+		t.Fatalf("expected %d virtual servers, got %v", len(expectedVS), vServers)
+	}
+	for _, vs := range vServers {
+		e := expectedVS[vs.Name]
+		if vs.Address != e.address || !vs.IPv4.Equal(net.ParseIP(e.v4)) || !vs.IPv6.Equal(net.ParseIP(e.v6)) {
+			t.Errorf("virtual server %s: expected %v got address=%s ipv4=%v ipv6=%v", vs.Name, e, vs.Address, vs.IPv4, vs.IPv6)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package a10go
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// A10AFlex is an aFleX script for AFlexList()
+type A10AFlex struct {
+	Name string `json:"name"`
+}
+
+// AFlexError reports aFleX script rejected by the device, usually due to syntax errors
+type AFlexError struct {
+	Name string // script name
+	Code int    // aXAPI error code
+	Msg  string // device message
+	Line int    // script line reported by the device, zero if unknown
+}
+
+func (e *AFlexError) Error() string {
+	return fmt.Sprintf("aflex %s: code=%d: %s", e.Name, e.Code, e.Msg)
+}
+
+// AFlexList retrieves aFleX scripts
+func (c *Client) AFlexList() []A10AFlex {
+	var list []A10AFlex
+	if err := templateGetAll(c, "slb.aflex", "aflex", &list); err != nil {
+		c.debugf("AFlexList: %v", err)
+	}
+	return list
+}
+
+// AFlexGet downloads aFleX script text
+func (c *Client) AFlexGet(name string) (string, error) {
+
+	me := "AFlexGet"
+
+	payload := fmt.Sprintf(`{ "name": "%s" }`, name)
+
+	body, errPost := a10SessionPostRead(c, "slb.aflex.download", payload)
+	if errPost != nil {
+		return "", fmt.Errorf(me+": name=%s error: %v", name, errPost)
+	}
+
+	// failures are reported as json, while the script is plain text
+	if trimmed := bytes.TrimSpace(body); len(trimmed) > 0 && trimmed[0] == '{' {
+		if errAPI := apiFailure(body); errAPI != nil {
+			return "", fmt.Errorf(me+": name=%s: %v", name, errAPI)
+		}
+	}
+
+	return string(body), nil
+}
+
+// AFlexUpload uploads aFleX script, replacing existing script of same name.
+// Scripts rejected by the device are reported as *AFlexError.
+// See AFlexValidate for checking a script without replacing the live one.
+func (c *Client) AFlexUpload(name, script string) error {
+	if err := aflexUpload(c, "AFlexUpload", name, name, script); err != nil {
+		return err
+	}
+	c.markUnsaved()
+	return nil
+}
+
+// AFlexValidate checks aFleX script on the device without touching the script of same name:
+// the script is uploaded under a temporary name, then deleted.
+// Scripts rejected by the device are reported as *AFlexError for name.
+func (c *Client) AFlexValidate(name, script string) error {
+
+	me := "AFlexValidate"
+
+	tmpName := fmt.Sprintf("a10go_validate_%x", time.Now().UnixNano())
+
+	if err := aflexUpload(c, me, name, tmpName, script); err != nil {
+		return err
+	}
+
+	payload := fmt.Sprintf(`{ "name": "%s" }`, tmpName)
+	if err := doPostCheck(c, me, "slb.aflex.delete", payload); err != nil {
+		return fmt.Errorf(me+": name=%s: delete temporary script=%s: %v", name, tmpName, err)
+	}
+
+	return nil
+}
+
+// aflexUpload uploads script as fileName, reporting rejection as *AFlexError for name
+func aflexUpload(c *Client, me, name, fileName, script string) error {
+
+	body, errPost := multipartUpload(c, "slb.aflex.upload", map[string]string{"name": fileName}, fileName, []byte(script))
+
+	c.debugf(me+": name=%s file=%s size=%d respBody=[%s] error=[%v]", name, fileName, len(script), body, errPost)
+
+	if errPost != nil {
+		return fmt.Errorf(me+": name=%s error: %v", name, errPost)
+	}
+
+	return aflexError(name, body)
+}
+
+// AFlexDelete deletes aFleX script
+func (c *Client) AFlexDelete(name string) error {
+	return templateDelete(c, "slb.aflex", name)
+}
+
+// AFlexBind binds aFleX script to virtual port "port,protocol" of virtual server
+func (c *Client) AFlexBind(virtualServer, virtualPort, aflex string) error {
+	return aflexBind(c, "AFlexBind", virtualServer, virtualPort, func(list []string) []string {
+		for _, a := range list {
+			if a == aflex {
+				return list // already bound
+			}
+		}
+		return append(list, aflex)
+	})
+}
+
+// AFlexUnbind unbinds aFleX script from virtual port "port,protocol" of virtual server
+func (c *Client) AFlexUnbind(virtualServer, virtualPort, aflex string) error {
+	return aflexBind(c, "AFlexUnbind", virtualServer, virtualPort, func(list []string) []string {
+		keep := []string{} // empty, not nil, list clears device list
+		for _, a := range list {
+			if a != aflex {
+				keep = append(keep, a)
+			}
+		}
+		return keep
+	})
+}
+
+// aflexBind rewrites the aFleX list of virtual port with change
+func aflexBind(c *Client, me, virtualServer, virtualPort string, change func([]string) []string) error {
+
+	port, proto := splitPortProto(c.debugf, virtualPort)
+
+	list, errList := a10VirtualServerList(c)
+	if errList != nil {
+		return fmt.Errorf(me+": virtual_server=%s: list: %v", virtualServer, errList)
+	}
+	vs, found := findVirtualServer(list, virtualServer)
+	if !found {
+		return fmt.Errorf(me+": virtual_server=%s: not found", virtualServer)
+	}
+
+	for _, p := range vs.VirtualPorts {
+		if p.Port != port || p.Protocol != proto {
+			continue
+		}
+		p.AFlex = change(p.AFlex)
+		format := `{ "name": "%s", "vport": %s }`
+		payload := fmt.Sprintf(format, virtualServer, virtualPortFormat(p))
+		return doPost(c, me, "slb.virtual_server.vport.update", payload)
+	}
+
+	return fmt.Errorf(me+": virtual_server=%s: virtual port %s/%s not found", virtualServer, port, proto)
+}
+
+// aflexLine finds script line number in device message
+var aflexLine = regexp.MustCompile(`[Ll]ine[: ]+(\d+)`)
+
+// aflexError converts upload failure into *AFlexError
+//
+//	{"response": {"status": "fail", "err": {"code": 67305473, "msg": "aFleX script syntax error: line 3: missing close-brace"}}}
+func aflexError(name string, body []byte) error {
+	var resp struct {
+		Response struct {
+			Status string `json:"status"`
+			Err    struct {
+				Code int    `json:"code"`
+				Msg  string `json:"msg"`
+			} `json:"err"`
+		} `json:"response"`
+	}
+	if errJSON := json.Unmarshal(body, &resp); errJSON != nil {
+		return fmt.Errorf("aflexError: name=%s bad response: [%s]", name, string(body))
+	}
+	if resp.Response.Status == "OK" {
+		return nil
+	}
+	e := &AFlexError{Name: name, Code: resp.Response.Err.Code, Msg: resp.Response.Err.Msg}
+	if m := aflexLine.FindStringSubmatch(e.Msg); m != nil {
+		e.Line, _ = strconv.Atoi(m[1])
+	}
+	return e
+}
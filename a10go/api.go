@@ -0,0 +1,163 @@
+package a10go
+
+import (
+	"time"
+)
+
+// API is the set of operations implemented by Client.
+// Code depending on API instead of *Client can be tested against a mock
+// implementation, such as a10gomock.Mock.
+type API interface {
+	Login(username, password string) error
+	Logout() error
+
+	Get(method string) ([]byte, error)
+	Post(method, body string) ([]byte, error)
+
+	PartitionList() []A10Partition
+	SwitchPartition(name string) error
+	Partition() string
+
+	WriteMemory() error
+	WriteMemoryPartition(partition string) error
+	WriteMemoryAll() error
+	Unsaved() bool
+
+	CLIShow(cmd string) (string, error)
+	CLIDeploy(commands []string) (string, error)
+
+	ServerList() []A10Server
+	ServerCreate(name, host string, ports []string) error
+	ServerUpdate(name, host string, ports []string) error
+	ServerCreateTemplate(name, host, template string, ports []string) error
+	ServerUpdateTemplate(name, host, template string, ports []string) error
+	ServerDelete(name string) error
+
+	ServiceGroupList() []A10ServiceGroup
+	ServiceGroupCreate(name, protocol string, members []string) error
+	ServiceGroupUpdate(name, protocol string, members []string) error
+	ServiceGroupDelete(name string) error
+	ServiceGroupMemberAdd(group, member string) error
+	ServiceGroupMemberDelete(group, member string) error
+
+	VirtualServerList() []A10VServer
+	VirtualServerCreate(name, address string, virtualPorts []string) error
+	VirtualServerUpdate(name, address string, virtualPorts []string) error
+	VirtualServerCreateTemplate(name, address, template string, virtualPorts []string) error
+	VirtualServerUpdateTemplate(name, address, template string, virtualPorts []string) error
+	VirtualServerDelete(name string) error
+
+	ServerCreateBatch(servers []A10Server, opt BatchOptions) ([]BatchResult, error)
+	ServerDeleteBatch(names []string, opt BatchOptions) ([]BatchResult, error)
+	ServiceGroupMemberAddBatch(group string, members []string, opt BatchOptions) ([]BatchResult, error)
+
+	ServerEnsure(name, host string, ports []string) (EnsureResult, error)
+	ServerEnsureTemplate(name, host, template string, ports []string) (EnsureResult, error)
+	ServiceGroupEnsure(name, protocol string, members []string) (EnsureResult, error)
+	VirtualServerEnsure(name, address string, virtualPorts []string) (EnsureResult, error)
+	VirtualServerEnsureTemplate(name, address, template string, virtualPorts []string) (EnsureResult, error)
+
+	ServerPatch(name string, spec ServerPatchSpec) error
+	ServerReplace(name, host string, ports []string) error
+	ServerReplaceTemplate(name, host, template string, ports []string) error
+	ServiceGroupPatch(name string, spec ServiceGroupPatchSpec) error
+	ServiceGroupReplace(name, protocol string, members []string) error
+	VirtualServerPatch(name string, spec VirtualServerPatchSpec) error
+	VirtualServerReplace(name, address string, virtualPorts []string) error
+	VirtualServerReplaceTemplate(name, address, template string, virtualPorts []string) error
+
+	Begin() Transaction
+
+	CookiePersistenceTemplateList() []A10CookiePersistenceTemplate
+	CookiePersistenceTemplateCreate(t A10CookiePersistenceTemplate) error
+	CookiePersistenceTemplateUpdate(t A10CookiePersistenceTemplate) error
+	CookiePersistenceTemplateDelete(name string) error
+	SrcIPPersistenceTemplateList() []A10SrcIPPersistenceTemplate
+	SrcIPPersistenceTemplateCreate(t A10SrcIPPersistenceTemplate) error
+	SrcIPPersistenceTemplateUpdate(t A10SrcIPPersistenceTemplate) error
+	SrcIPPersistenceTemplateDelete(name string) error
+	DstIPPersistenceTemplateList() []A10DstIPPersistenceTemplate
+	DstIPPersistenceTemplateCreate(t A10DstIPPersistenceTemplate) error
+	DstIPPersistenceTemplateUpdate(t A10DstIPPersistenceTemplate) error
+	DstIPPersistenceTemplateDelete(name string) error
+
+	ClientSSLTemplateList() []A10ClientSSLTemplate
+	ClientSSLTemplateCreate(t A10ClientSSLTemplate) error
+	ClientSSLTemplateUpdate(t A10ClientSSLTemplate) error
+	ClientSSLTemplateDelete(name string) error
+	ServerSSLTemplateList() []A10ServerSSLTemplate
+	ServerSSLTemplateCreate(t A10ServerSSLTemplate) error
+	ServerSSLTemplateUpdate(t A10ServerSSLTemplate) error
+	ServerSSLTemplateDelete(name string) error
+
+	SSLCertList() []A10SSLCert
+	SSLCertGet(name string) (A10SSLCert, error)
+	SSLCertUpload(name string, certPEM, keyPEM []byte, passphrase string) (A10SSLCert, error)
+	SSLCertDelete(name string) error
+
+	CertExpiring(within time.Duration) ([]CertExpiry, error)
+	CertRotate(template, name string, certPEM, keyPEM []byte, passphrase string) (A10SSLCert, error)
+
+	HTTPTemplateList() []A10HTTPTemplate
+	HTTPTemplateCreate(t A10HTTPTemplate) error
+	HTTPTemplateUpdate(t A10HTTPTemplate) error
+	HTTPTemplateDelete(name string) error
+
+	TCPTemplateList() []A10TCPTemplate
+	TCPTemplateCreate(t A10TCPTemplate) error
+	TCPTemplateUpdate(t A10TCPTemplate) error
+	TCPTemplateDelete(name string) error
+	UDPTemplateList() []A10UDPTemplate
+	UDPTemplateCreate(t A10UDPTemplate) error
+	UDPTemplateUpdate(t A10UDPTemplate) error
+	UDPTemplateDelete(name string) error
+	ConnReuseTemplateList() []A10ConnReuseTemplate
+	ConnReuseTemplateCreate(t A10ConnReuseTemplate) error
+	ConnReuseTemplateUpdate(t A10ConnReuseTemplate) error
+	ConnReuseTemplateDelete(name string) error
+
+	ServerTemplateList() []A10ServerTemplate
+	ServerTemplateCreate(t A10ServerTemplate) error
+	ServerTemplateUpdate(t A10ServerTemplate) error
+	ServerTemplateDelete(name string) error
+	PortTemplateList() []A10PortTemplate
+	PortTemplateCreate(t A10PortTemplate) error
+	PortTemplateUpdate(t A10PortTemplate) error
+	PortTemplateDelete(name string) error
+
+	VirtualServerTemplateList() []A10VirtualServerTemplate
+	VirtualServerTemplateCreate(t A10VirtualServerTemplate) error
+	VirtualServerTemplateUpdate(t A10VirtualServerTemplate) error
+	VirtualServerTemplateDelete(name string) error
+	VirtualPortTemplateList() []A10VirtualPortTemplate
+	VirtualPortTemplateCreate(t A10VirtualPortTemplate) error
+	VirtualPortTemplateUpdate(t A10VirtualPortTemplate) error
+	VirtualPortTemplateDelete(name string) error
+
+	NATPoolList() []A10NATPool
+	NATPoolCreate(p A10NATPool) error
+	NATPoolUpdate(p A10NATPool) error
+	NATPoolDelete(name string) error
+	NATPoolGroupList() []A10NATPoolGroup
+	NATPoolGroupCreate(g A10NATPoolGroup) error
+	NATPoolGroupUpdate(g A10NATPoolGroup) error
+	NATPoolGroupDelete(name string) error
+
+	AFlexList() []A10AFlex
+	AFlexGet(name string) (string, error)
+	AFlexUpload(name, script string) error
+	AFlexValidate(name, script string) error
+	AFlexDelete(name string) error
+	AFlexBind(virtualServer, virtualPort, aflex string) error
+	AFlexUnbind(virtualServer, virtualPort, aflex string) error
+
+	ClassListList() []A10ClassList
+	ClassListCreate(cl A10ClassList) error
+	ClassListUpdate(cl A10ClassList) error
+	ClassListDelete(name string) error
+	ClassListAdd(name string, entries A10ClassList) error
+	ClassListRemove(name string, entries A10ClassList) error
+	ClassListImport(name string, content []byte) error
+}
+
+var _ API = (*Client)(nil) // Client must implement API
@@ -0,0 +1,134 @@
+package a10go
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrBatchSkipped is the result error for batch items not attempted because
+// the batch was stopped by an earlier failure (see BatchOptions.StopOnError)
+var ErrBatchSkipped = errors.New("batch item skipped after earlier error")
+
+const defaultBatchWorkers = 4
+
+// BatchOptions specify how a batch operation is executed
+type BatchOptions struct {
+	Workers     int  // concurrent workers (defaults to 4)
+	StopOnError bool // stop issuing new items after first failure, otherwise best effort
+}
+
+// BatchResult is the outcome for one batch item
+type BatchResult struct {
+	Index int    // item position in batch input
+	Name  string // item name
+	Err   error  // item error, ErrBatchSkipped if not attempted
+}
+
+// ServerCreateBatch creates multiple servers concurrently.
+// Results are returned in input order.
+func (c *Client) ServerCreateBatch(servers []A10Server, opt BatchOptions) ([]BatchResult, error) {
+	return runBatch(c, "ServerCreateBatch", len(servers), opt,
+		func(i int) string { return servers[i].Name },
+		func(i int) error {
+			s := servers[i]
+			return c.ServerCreateTemplate(s.Name, s.Host, s.Template, portStrings(s.Ports))
+		})
+}
+
+// ServerDeleteBatch deletes multiple servers concurrently.
+// Results are returned in input order.
+func (c *Client) ServerDeleteBatch(names []string, opt BatchOptions) ([]BatchResult, error) {
+	return runBatch(c, "ServerDeleteBatch", len(names), opt,
+		func(i int) string { return names[i] },
+		func(i int) error { return c.ServerDelete(names[i]) })
+}
+
+// ServiceGroupMemberAddBatch adds multiple members to service group concurrently.
+// members is list of "serverName,portNumber".
+// Results are returned in input order.
+func (c *Client) ServiceGroupMemberAddBatch(group string, members []string, opt BatchOptions) ([]BatchResult, error) {
+	return runBatch(c, "ServiceGroupMemberAddBatch", len(members), opt,
+		func(i int) string { return members[i] },
+		func(i int) error { return c.ServiceGroupMemberAdd(group, members[i]) })
+}
+
+// portStrings converts ports to the "portNumber,portProtocol[,template=portTemplate]" form used by ServerCreate
+func portStrings(ports []A10Port) []string {
+	var list []string
+	for _, p := range ports {
+		str := p.Number
+		if p.Protocol != "" {
+			str += "," + p.Protocol
+		}
+		if p.Template != "" {
+			str += ",template=" + p.Template
+		}
+		list = append(list, str)
+	}
+	return list
+}
+
+// runBatch calls do for items 0..count-1 from a bounded pool of workers.
+// Every call goes through the client, thus honoring its limiter.
+// If every item succeeds, the configuration is saved if Options.AutoSave is set.
+func runBatch(c *Client, caller string, count int, opt BatchOptions, name func(int) string, do func(int) error) ([]BatchResult, error) {
+
+	workers := opt.Workers
+	if workers < 1 {
+		workers = defaultBatchWorkers
+	}
+	if workers > count {
+		workers = count
+	}
+
+	results := make([]BatchResult, count)
+	for i := range results {
+		results[i] = BatchResult{Index: i, Name: name(i), Err: ErrBatchSkipped}
+	}
+
+	var stop int32
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				err := do(i)
+				results[i].Err = err
+				if err != nil && opt.StopOnError {
+					atomic.StoreInt32(&stop, 1)
+				}
+			}
+		}()
+	}
+
+	for i := 0; i < count && atomic.LoadInt32(&stop) == 0; i++ {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	var failed int
+	var first error
+	for _, r := range results {
+		if r.Err == nil {
+			continue
+		}
+		if first == nil {
+			first = fmt.Errorf("item=%d name=%s: %w", r.Index, r.Name, r.Err)
+		}
+		failed++
+	}
+
+	c.debugf("%s: items=%d workers=%d failed=%d", caller, count, workers, failed)
+
+	if failed > 0 {
+		return results, fmt.Errorf("%s: %d/%d items failed, first: %w", caller, failed, count, first)
+	}
+
+	return results, autoSave(c, caller)
+}
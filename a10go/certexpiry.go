@@ -0,0 +1,121 @@
+package a10go
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// CertExpiry reports a certificate due to expire, and where it is used
+type CertExpiry struct {
+	Cert         A10SSLCert
+	Unknown      bool      // expiration date could not be parsed, Cert.NotAfter is zero
+	Templates    []string  // client-SSL templates using the certificate, as server or chain certificate
+	VirtualPorts []CertVIP // virtual ports using those templates
+}
+
+// CertVIP is a virtual port affected by an expiring certificate
+type CertVIP struct {
+	VirtualServer string
+	Address       string
+	Port          string
+	Template      string // client-SSL template
+}
+
+// CertExpiring reports certificates expiring within the given duration from now,
+// including already expired ones, sorted by expiration.
+// Intermediate certificates are reported with the templates referencing them as chain.
+// Certificates with unknown expiration are reported first, marked as Unknown.
+func (c *Client) CertExpiring(within time.Duration) ([]CertExpiry, error) {
+
+	me := "CertExpiring"
+
+	certs, errCerts := sslCertList(c)
+	if errCerts != nil {
+		return nil, fmt.Errorf(me+": certificates: %v", errCerts)
+	}
+
+	var templates []A10ClientSSLTemplate
+	if err := templateGetAll(c, prefixClientSSL, keyClientSSL, &templates); err != nil {
+		return nil, fmt.Errorf(me+": client-SSL templates: %v", err)
+	}
+
+	vServers, errVS := a10VirtualServerList(c)
+	if errVS != nil {
+		return nil, fmt.Errorf(me+": virtual servers: %v", errVS)
+	}
+
+	deadline := time.Now().Add(within)
+
+	var report []CertExpiry
+
+	for _, cert := range certs {
+		unknown := cert.NotAfter.IsZero()
+		if !unknown && cert.NotAfter.After(deadline) {
+			continue
+		}
+
+		e := CertExpiry{Cert: cert, Unknown: unknown}
+
+		for _, t := range templates {
+			if t.CertName != cert.Name && t.ChainCertName != cert.Name {
+				continue
+			}
+			e.Templates = append(e.Templates, t.Name)
+			for _, vs := range vServers {
+				for _, vp := range vs.VirtualPorts {
+					if vp.ClientSSLTemplate == t.Name {
+						e.VirtualPorts = append(e.VirtualPorts, CertVIP{VirtualServer: vs.Name, Address: vs.Address, Port: vp.Port, Template: t.Name})
+					}
+				}
+			}
+		}
+
+		report = append(report, e)
+	}
+
+	sort.Slice(report, func(i, j int) bool { return report[i].Cert.NotAfter.Before(report[j].Cert.NotAfter) })
+
+	return report, nil
+}
+
+// CertRotate uploads a new certificate and switches client-SSL template to it in one step.
+// The previous certificate is kept on the device.
+func (c *Client) CertRotate(template, name string, certPEM, keyPEM []byte, passphrase string) (A10SSLCert, error) {
+
+	me := "CertRotate"
+
+	var templates []A10ClientSSLTemplate
+	if err := templateGetAll(c, prefixClientSSL, keyClientSSL, &templates); err != nil {
+		return A10SSLCert{}, fmt.Errorf(me+": client-SSL templates: %v", err)
+	}
+
+	var t A10ClientSSLTemplate
+	var found bool
+	for _, tt := range templates {
+		if tt.Name == template {
+			t, found = tt, true
+			break
+		}
+	}
+	if !found {
+		return A10SSLCert{}, fmt.Errorf(me+": client-SSL template=%s not found", template)
+	}
+
+	cert, errUpload := c.SSLCertUpload(name, certPEM, keyPEM, passphrase)
+	if errUpload != nil {
+		return cert, fmt.Errorf(me+": %v", errUpload)
+	}
+
+	t.CertName = name
+	if len(keyPEM) > 0 {
+		t.KeyName = name
+		t.PassPhrase = passphrase
+	}
+
+	if err := c.ClientSSLTemplateUpdate(t); err != nil {
+		return cert, fmt.Errorf(me+": template=%s: %v", template, err)
+	}
+
+	return cert, nil
+}
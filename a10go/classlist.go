@@ -0,0 +1,142 @@
+package a10go
+
+import (
+	"fmt"
+)
+
+// ClassListType selects class list entry kind
+type ClassListType int
+
+// Class list types
+const (
+	ClassListAddress ClassListType = 0 // IPv4 and IPv6 entries
+	ClassListString  ClassListType = 1 // string entries
+)
+
+// Ptr returns a pointer to t, for the optional A10ClassList.Type
+func (t ClassListType) Ptr() *ClassListType {
+	return &t
+}
+
+// A10ClassList is a class list (slb.class_list), used by aFleX scripts and ACLs for lookups
+type A10ClassList struct {
+	Name    string               `json:"name"`
+	Type    *ClassListType       `json:"type,omitempty"` // nil keeps current type on update
+	IPv4    []A10ClassListIPv4   `json:"ipv4_list,omitempty"`
+	IPv6    []A10ClassListIPv6   `json:"ipv6_list,omitempty"`
+	Strings []A10ClassListString `json:"str_list,omitempty"`
+}
+
+// A10ClassListIPv4 is an IPv4 network entry
+type A10ClassListIPv4 struct {
+	Addr string `json:"ipv4_addr"`
+	Mask string `json:"ipv4_mask"`
+	LID  int    `json:"lid,omitempty"` // limit ID applied to matching clients
+}
+
+// A10ClassListIPv6 is an IPv6 network entry
+type A10ClassListIPv6 struct {
+	Addr      string `json:"ipv6_addr"`
+	PrefixLen int    `json:"ipv6_prefix_len"`
+	LID       int    `json:"lid,omitempty"`
+}
+
+// A10ClassListString is a string entry, with optional value returned by aFleX lookups
+type A10ClassListString struct {
+	Key   string `json:"key_string"`
+	Value string `json:"value_string,omitempty"`
+}
+
+const (
+	prefixClassList = "slb.class_list"
+	keyClassList    = "class_list"
+)
+
+// ClassListList retrieves class lists
+func (c *Client) ClassListList() []A10ClassList {
+	var list []A10ClassList
+	if err := templateGetAll(c, prefixClassList, keyClassList, &list); err != nil {
+		c.debugf("ClassListList: %v", err)
+	}
+	return list
+}
+
+// ClassListCreate creates class list
+func (c *Client) ClassListCreate(cl A10ClassList) error {
+	return templatePost(c, prefixClassList, keyClassList, "create", cl)
+}
+
+// ClassListUpdate updates class list.
+// The device merges given entries into the existing ones, see ClassListRemove for removing entries.
+func (c *Client) ClassListUpdate(cl A10ClassList) error {
+	return templatePost(c, prefixClassList, keyClassList, "update", cl)
+}
+
+// ClassListDelete deletes class list
+func (c *Client) ClassListDelete(name string) error {
+	return templateDelete(c, prefixClassList, name)
+}
+
+// ClassListAdd adds entries to class list, modifying existing entries with same address or key.
+// Name and Type of entries are ignored: the class list type is left unchanged.
+func (c *Client) ClassListAdd(name string, entries A10ClassList) error {
+	e := A10ClassList{Name: name, IPv4: entries.IPv4, IPv6: entries.IPv6, Strings: entries.Strings}
+	return templatePost(c, prefixClassList, keyClassList, "update", e)
+}
+
+// ClassListRemove removes entries from class list, matching by address or key.
+// Entries are removed one by one, thus ClassListImport is preferred for replacing large lists.
+func (c *Client) ClassListRemove(name string, entries A10ClassList) error {
+
+	me := "ClassListRemove"
+
+	for _, e := range entries.IPv4 {
+		format := `{ "name": "%s", "ipv4": {"ipv4_addr": "%s", "ipv4_mask": "%s"} }`
+		payload := fmt.Sprintf(format, name, e.Addr, e.Mask)
+		if err := doPost(c, me, prefixClassList+".ipv4.delete", payload); err != nil {
+			return err
+		}
+	}
+	for _, e := range entries.IPv6 {
+		format := `{ "name": "%s", "ipv6": {"ipv6_addr": "%s", "ipv6_prefix_len": %d} }`
+		payload := fmt.Sprintf(format, name, e.Addr, e.PrefixLen)
+		if err := doPost(c, me, prefixClassList+".ipv6.delete", payload); err != nil {
+			return err
+		}
+	}
+	for _, e := range entries.Strings {
+		format := `{ "name": "%s", "str": {"key_string": "%s"} }`
+		payload := fmt.Sprintf(format, name, e.Key)
+		if err := doPost(c, me, prefixClassList+".string.delete", payload); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ClassListImport creates or replaces class list from file content in ACOS class-list file format:
+//
+//	10.0.0.0/8 lid 1
+//	2001:db8::/32 lid 2
+//	str example.com value1
+func (c *Client) ClassListImport(name string, content []byte) error {
+
+	me := "ClassListImport"
+
+	body, errPost := multipartUpload(c, prefixClassList+".upload", map[string]string{"name": name}, name, content)
+
+	c.debugf(me+": name=%s size=%d respBody=[%s] error=[%v]", name, len(content), body, errPost)
+
+	if errPost != nil {
+		return fmt.Errorf(me+": name=%s error: %v", name, errPost)
+	}
+
+	if errAPI := apiFailure(body); errAPI != nil {
+		return fmt.Errorf(me+": name=%s: %v", name, errAPI)
+	}
+
+	c.markUnsaved()
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package a10go
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// CLIError reports CLI command failure detected in the command output
+type CLIError struct {
+	Method string   // api method: cli.show_info or cli.deploy
+	Lines  []string // output lines reporting errors
+	Output string   // full command output
+}
+
+func (e *CLIError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Method, strings.Join(e.Lines, "; "))
+}
+
+// CLIShow runs a show command, like "show slb server", and returns its output.
+// CLIShow does not change configuration, hence it runs even in dry mode.
+func (c *Client) CLIShow(cmd string) (string, error) {
+	return cliRun(c, "cli.show_info", cmd, false)
+}
+
+// CLIDeploy runs configuration commands, one command per element, and returns their output.
+func (c *Client) CLIDeploy(commands []string) (string, error) {
+	output, err := cliRun(c, "cli.deploy", strings.Join(commands, "\n"), true)
+	if err == nil {
+		c.markUnsaved()
+	}
+	return output, err
+}
+
+func cliRun(c *Client, method, commands string, write bool) (string, error) {
+
+	body, errPost := a10SessionPostMode(c, method, contentTypeText, commands, write)
+
+	c.debugf("cliRun: method=%s commands=[%s] output=[%s] error=[%v]", method, commands, body, errPost)
+
+	if errPost != nil {
+		return string(body), fmt.Errorf("cliRun: method=%s error: %v", method, errPost)
+	}
+
+	// api failures are reported as json, while command output is plain text
+	if trimmed := bytes.TrimSpace(body); len(trimmed) > 0 && trimmed[0] == '{' {
+		if errAPI := apiFailure(body); errAPI != nil {
+			return string(body), fmt.Errorf("cliRun: method=%s: %v", method, errAPI)
+		}
+	}
+
+	output := string(body)
+
+	if lines := cliErrorLines(output); len(lines) > 0 {
+		return output, &CLIError{Method: method, Lines: lines, Output: output}
+	}
+
+	return output, nil
+}
+
+// cliErrorLines finds ACOS CLI error messages in command output:
+//
+//	% Invalid input detected at '^' marker.
+//	Error: No such server
+func cliErrorLines(output string) []string {
+	var lines []string
+	for _, line := range strings.Split(output, "\n") {
+		l := strings.TrimSpace(line)
+		if strings.HasPrefix(l, "% ") || strings.HasPrefix(l, "Error:") {
+			lines = append(lines, l)
+		}
+	}
+	return lines
+}
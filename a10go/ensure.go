@@ -0,0 +1,230 @@
+package a10go
+
+import (
+	"fmt"
+	"sort"
+)
+
+// EnsureResult tells which change an Ensure operation applied
+type EnsureResult int
+
+// Ensure operation results
+const (
+	EnsureUnchanged EnsureResult = iota // object already matched
+	EnsureCreated                       // object was missing and has been created
+	EnsureUpdated                       // object differed and has been updated
+)
+
+func (r EnsureResult) String() string {
+	switch r {
+	case EnsureUnchanged:
+		return "unchanged"
+	case EnsureCreated:
+		return "created"
+	case EnsureUpdated:
+		return "updated"
+	}
+	return fmt.Sprintf("EnsureResult(%d)", int(r))
+}
+
+// ServerEnsure creates server if missing, or replaces it if different (see ServerReplace).
+// ports is list of "portName,portProtocol[,template=portTemplate]"
+func (c *Client) ServerEnsure(name, host string, ports []string) (EnsureResult, error) {
+	return c.ServerEnsureTemplate(name, host, "", ports)
+}
+
+// ServerEnsureTemplate is ServerEnsure with server template.
+// An empty template selects the default template.
+func (c *Client) ServerEnsureTemplate(name, host, template string, ports []string) (EnsureResult, error) {
+	list, errList := a10ServerList(c)
+	if errList != nil {
+		return EnsureUnchanged, fmt.Errorf("ServerEnsure: name=%s: list: %v", name, errList)
+	}
+
+	current, found := findServer(list, name)
+	if !found {
+		return ensured(EnsureCreated, c.ServerCreateTemplate(name, host, template, ports))
+	}
+
+	if sameServer(c.debugf, current, host, template, ports) {
+		return EnsureUnchanged, nil
+	}
+
+	return ensured(EnsureUpdated, serverReplace(c, current, host, template, ports))
+}
+
+// sameServer compares device server s with host, server template and ports
+func sameServer(debugf FuncPrintf, s A10Server, host, template string, ports []string) bool {
+	return sameIP(s.Host, host) &&
+		templateKey(s.Template) == templateKey(template) &&
+		equalKeys(serverPortKeys(s.Ports), portKeys(debugf, ports))
+}
+
+// ServiceGroupEnsure creates service group if missing, or replaces it if different (see ServiceGroupReplace).
+// members is list of "serverName,portNumber"
+func (c *Client) ServiceGroupEnsure(name, protocol string, members []string) (EnsureResult, error) {
+	list, errList := a10ServiceGroupList(c)
+	if errList != nil {
+		return EnsureUnchanged, fmt.Errorf("ServiceGroupEnsure: name=%s: list: %v", name, errList)
+	}
+
+	current, found := findServiceGroup(list, name)
+	if !found {
+		return ensured(EnsureCreated, c.ServiceGroupCreate(name, protocol, members))
+	}
+
+	if current.Protocol == protocol && equalKeys(sgMemberKeys(current.Members), memberKeys(c.debugf, members)) {
+		return EnsureUnchanged, nil
+	}
+
+	return ensured(EnsureUpdated, serviceGroupReplace(c, current, protocol, members))
+}
+
+// VirtualServerEnsure creates virtual server if missing, or replaces it if different (see VirtualServerReplace).
+// virtualPorts is list of "serviceGroup,port,protocol[,field=value...]"
+func (c *Client) VirtualServerEnsure(name, address string, virtualPorts []string) (EnsureResult, error) {
+	return c.VirtualServerEnsureTemplate(name, address, "", virtualPorts)
+}
+
+// VirtualServerEnsureTemplate is VirtualServerEnsure with virtual-server template.
+// An empty template selects the default template.
+func (c *Client) VirtualServerEnsureTemplate(name, address, template string, virtualPorts []string) (EnsureResult, error) {
+	list, errList := a10VirtualServerList(c)
+	if errList != nil {
+		return EnsureUnchanged, fmt.Errorf("VirtualServerEnsure: name=%s: list: %v", name, errList)
+	}
+
+	current, found := findVirtualServer(list, name)
+	if !found {
+		return ensured(EnsureCreated, c.VirtualServerCreateTemplate(name, address, template, virtualPorts))
+	}
+
+	if sameVirtualServer(c.debugf, current, address, template, virtualPorts) {
+		return EnsureUnchanged, nil
+	}
+
+	return ensured(EnsureUpdated, virtualServerReplace(c, current, address, template, virtualPorts))
+}
+
+// sameVirtualServer compares device virtual server vs with address, virtual-server template and virtual ports
+func sameVirtualServer(debugf FuncPrintf, vs A10VServer, address, template string, virtualPorts []string) bool {
+	return sameAddress(vs.Address, address) &&
+		templateKey(vs.Template) == templateKey(template) &&
+		equalKeys(vServerPortKeys(vs.VirtualPorts), virtualPortKeys(debugf, virtualPorts))
+}
+
+// ensured reports result r only if change was applied successfully
+func ensured(r EnsureResult, err error) (EnsureResult, error) {
+	if err != nil {
+		return EnsureUnchanged, err
+	}
+	return r, nil
+}
+
+func findServer(list []A10Server, name string) (A10Server, bool) {
+	for _, s := range list {
+		if s.Name == name {
+			return s, true
+		}
+	}
+	return A10Server{}, false
+}
+
+func findServiceGroup(list []A10ServiceGroup, name string) (A10ServiceGroup, bool) {
+	for _, g := range list {
+		if g.Name == name {
+			return g, true
+		}
+	}
+	return A10ServiceGroup{}, false
+}
+
+func findVirtualServer(list []A10VServer, name string) (A10VServer, bool) {
+	for _, vs := range list {
+		if vs.Name == name {
+			return vs, true
+		}
+	}
+	return A10VServer{}, false
+}
+
+// The *Keys functions normalize ports/members into sorted comparable keys.
+
+func serverPortKeys(ports []A10Port) []string {
+	var keys []string
+	for _, p := range ports {
+		keys = append(keys, p.Number+"/"+p.Protocol+"/"+templateKey(p.Template))
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func portKeys(debugf FuncPrintf, ports []string) []string {
+	var keys []string
+	for _, p := range ports {
+		port := parsePort(debugf, p)
+		keys = append(keys, port.Number+"/"+port.Protocol+"/"+templateKey(port.Template))
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// defaultTemplate is the template the device assigns to servers, ports and virtual servers without explicit template
+const defaultTemplate = "default"
+
+// templateKey normalizes template name for comparison, since the device reports no template as "default"
+func templateKey(template string) string {
+	if template == defaultTemplate {
+		return ""
+	}
+	return template
+}
+
+func sgMemberKeys(members []A10SGMember) []string {
+	var keys []string
+	for _, m := range members {
+		keys = append(keys, m.Name+"/"+m.Port)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func memberKeys(debugf FuncPrintf, members []string) []string {
+	var keys []string
+	for _, m := range members {
+		name, port := splitMemberPortProto(debugf, m)
+		keys = append(keys, name+"/"+port)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func vServerPortKeys(ports []A10VirtualPort) []string {
+	var keys []string
+	for _, p := range ports {
+		keys = append(keys, virtualPortKey(p))
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func virtualPortKeys(debugf FuncPrintf, virtualPorts []string) []string {
+	var keys []string
+	for _, p := range virtualPorts {
+		keys = append(keys, virtualPortKey(parseVirtualPort(debugf, p)))
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func equalKeys(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
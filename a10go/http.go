@@ -11,19 +11,9 @@ import (
 	"time"
 )
 
-func tlsConfig() *tls.Config {
-	return &tls.Config{
-		//CipherSuites:             []uint16{tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA, tls.TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA},
-		PreferServerCipherSuites: true,
-		InsecureSkipVerify:       true,
-		//MaxVersion:               tls.VersionTLS11,
-		//MinVersion:               tls.VersionTLS11,
-	}
-}
-
-func httpClient() *http.Client {
+func newHTTPClient(tlsConf *tls.Config) *http.Client {
 	tr := &http.Transport{
-		TLSClientConfig:    tlsConfig(),
+		TLSClientConfig:    tlsConf,
 		DisableCompression: true,
 		DisableKeepAlives:  true,
 		Dial: (&net.Dialer{
@@ -40,27 +30,22 @@ func httpClient() *http.Client {
 	}
 }
 
-func httpPostString(url, contentType, s string) ([]byte, error) {
-	return httpPost(url, contentType, bytes.NewBufferString(s))
-}
-
-func httpDeleteString(url, contentType, s string) ([]byte, error) {
-	return httpDelete(url, contentType, bytes.NewBufferString(s))
+// StatusError reports an http response with non-200 status
+type StatusError struct {
+	Caller     string
+	StatusCode int
 }
 
-func httpPost(url, contentType string, body io.Reader) ([]byte, error) {
-	c := httpClient()
-	return clientPost(c, url, contentType, body)
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("%s: bad status: %d", e.Caller, e.StatusCode)
 }
 
-func httpGet(url string) ([]byte, error) {
-	c := httpClient()
-	return clientGet(c, url)
+func httpPostString(c *http.Client, url, contentType, s string) ([]byte, error) {
+	return clientPost(c, url, contentType, bytes.NewBufferString(s))
 }
 
-func httpDelete(url string, contentType string, body io.Reader) ([]byte, error) {
-	c := httpClient()
-	return clientDelete(c, url, contentType, body)
+func httpDeleteString(c *http.Client, url, contentType, s string) ([]byte, error) {
+	return clientDelete(c, url, contentType, bytes.NewBufferString(s))
 }
 
 func clientDelete(c *http.Client, url, bodyContentType string, body io.Reader) ([]byte, error) {
@@ -84,11 +69,11 @@ func clientMethod(c *http.Client, method, url, bodyContentType string, body io.R
 
 	info, errRead := ioutil.ReadAll(resp.Body)
 	if errRead != nil {
-		return info, fmt.Errorf("http method=%s: read all: url=%v: %v", method, url, errRead)
+		return info, fmt.Errorf("http method=%s: read all: url=%v: %w", method, url, errRead)
 	}
 
 	if resp.StatusCode != 200 {
-		return info, fmt.Errorf("http method=%s: bad status: %d", method, resp.StatusCode)
+		return info, &StatusError{Caller: "http method=" + method, StatusCode: resp.StatusCode}
 	}
 
 	return info, nil
@@ -105,11 +90,11 @@ func clientPost(c *http.Client, url string, contentType string, r io.Reader) ([]
 
 	info, errBody := ioutil.ReadAll(resp.Body)
 	if errBody != nil {
-		return info, fmt.Errorf("httpPost: read: url=%v: %v", url, errBody)
+		return info, fmt.Errorf("httpPost: read: url=%v: %w", url, errBody)
 	}
 
 	if resp.StatusCode != 200 {
-		return info, fmt.Errorf("httpPost: bad status: %d", resp.StatusCode)
+		return info, &StatusError{Caller: "httpPost", StatusCode: resp.StatusCode}
 	}
 
 	return info, nil
@@ -118,18 +103,18 @@ func clientPost(c *http.Client, url string, contentType string, r io.Reader) ([]
 func clientGet(c *http.Client, url string) ([]byte, error) {
 	resp, errGet := c.Get(url)
 	if errGet != nil {
-		return nil, fmt.Errorf("httpGet: get url=%v: %v", url, errGet)
+		return nil, fmt.Errorf("httpGet: get url=%v: %w", url, errGet)
 	}
 
 	defer resp.Body.Close()
 
 	info, errRead := ioutil.ReadAll(resp.Body)
 	if errRead != nil {
-		return info, fmt.Errorf("httpGet: read all: url=%v: %v", url, errRead)
+		return info, fmt.Errorf("httpGet: read all: url=%v: %w", url, errRead)
 	}
 
 	if resp.StatusCode != 200 {
-		return info, fmt.Errorf("httpGet: bad status: %d", resp.StatusCode)
+		return info, &StatusError{Caller: "httpGet", StatusCode: resp.StatusCode}
 	}
 
 	return info, nil
@@ -0,0 +1,104 @@
+package a10go
+
+// HTTPMatchType selects how host and URL switching rules match the request
+type HTTPMatchType int
+
+// HTTP switching match types
+const (
+	HTTPMatchStartsWith HTTPMatchType = 0
+	HTTPMatchContains   HTTPMatchType = 1
+	HTTPMatchEndsWith   HTTPMatchType = 2
+)
+
+// HTTPHeaderInsertType selects how an inserted header treats an existing header of same name
+type HTTPHeaderInsertType int
+
+// HTTP header insert types
+const (
+	HTTPHeaderInsertIfNotExist HTTPHeaderInsertType = 0 // insert only when header is missing
+	HTTPHeaderInsertAlways     HTTPHeaderInsertType = 1 // insert even when header is present
+	HTTPHeaderReplace          HTTPHeaderInsertType = 2 // replace existing header
+)
+
+// A10HTTPTemplate is an HTTP template (slb.template.http), used by HTTP and HTTPS virtual ports
+type A10HTTPTemplate struct {
+	Name                 string                `json:"name"`
+	FailoverURL          string                `json:"failover_url,omitempty"`                 // redirect target when no server is available
+	InsertClientIP       *Flag                 `json:"insert_client_ip,omitempty"`             // insert client address header
+	InsertClientIPHeader string                `json:"insert_client_ip_header_name,omitempty"` // defaults to X-Forwarded-For
+	HeaderInsert         []A10HTTPHeaderInsert `json:"header_insert_list,omitempty"`
+	HeaderErase          []A10HTTPHeaderErase  `json:"header_erase_list,omitempty"`
+	HostSwitching        []A10HTTPSwitching    `json:"host_switching_list,omitempty"`
+	URLSwitching         []A10HTTPSwitching    `json:"url_switching_list,omitempty"`
+	RedirectRewrite      *A10HTTPRedirect      `json:"redirect_rewrite,omitempty"`
+	Compression          *A10HTTPCompression   `json:"compression,omitempty"`
+}
+
+// A10HTTPHeaderInsert inserts a request header, given as "Name: value"
+type A10HTTPHeaderInsert struct {
+	Header string               `json:"header_insert_field"`
+	Type   HTTPHeaderInsertType `json:"header_insert_type"`
+}
+
+// A10HTTPHeaderErase removes a request header
+type A10HTTPHeaderErase struct {
+	Header string `json:"header_erase_field"`
+}
+
+// A10HTTPSwitching sends requests matching host or URL to a service group.
+// Match is a host for host switching, and an URL path for URL switching.
+type A10HTTPSwitching struct {
+	Type         HTTPMatchType `json:"switching_type"`
+	Match        string        `json:"match_string"`
+	ServiceGroup string        `json:"service_group"`
+}
+
+// A10HTTPRedirect rewrites Location headers of server redirects
+type A10HTTPRedirect struct {
+	Rules       []A10HTTPRedirectRule `json:"redirect_rewrite_list,omitempty"`
+	RewriteHTTP *Flag                 `json:"https_rewrite,omitempty"` // rewrite http:// redirects to https://
+	HTTPSPort   *int                  `json:"https_port,omitempty"`    // port for rewritten https:// redirects
+}
+
+// A10HTTPRedirectRule rewrites redirects matching Match into RewriteTo
+type A10HTTPRedirectRule struct {
+	Match     string `json:"match"`
+	RewriteTo string `json:"rewrite_to"`
+}
+
+// A10HTTPCompression compresses server responses
+type A10HTTPCompression struct {
+	Enable             Flag  `json:"enable"`
+	Level              *int  `json:"level,omitempty"`              // 1 (fastest) to 9 (best)
+	MinContentLength   *int  `json:"min_content_length,omitempty"` // bytes
+	KeepAcceptEncoding *Flag `json:"keep_accept_encoding,omitempty"`
+}
+
+const (
+	prefixHTTP = "slb.template.http"
+	keyHTTP    = "http_template"
+)
+
+// HTTPTemplateList retrieves HTTP templates
+func (c *Client) HTTPTemplateList() []A10HTTPTemplate {
+	var list []A10HTTPTemplate
+	if err := templateGetAll(c, prefixHTTP, keyHTTP, &list); err != nil {
+		c.debugf("HTTPTemplateList: %v", err)
+	}
+	return list
+}
+
+// HTTPTemplateCreate creates HTTP template
+func (c *Client) HTTPTemplateCreate(t A10HTTPTemplate) error {
+	return templatePost(c, prefixHTTP, keyHTTP, "create", t)
+}
+
+// HTTPTemplateUpdate updates HTTP template
+func (c *Client) HTTPTemplateUpdate(t A10HTTPTemplate) error {
+	return templatePost(c, prefixHTTP, keyHTTP, "update", t)
+}
+
+// HTTPTemplateDelete deletes HTTP template
+func (c *Client) HTTPTemplateDelete(name string) error {
+	return templateDelete(c, prefixHTTP, name)
+}
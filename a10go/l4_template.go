@@ -0,0 +1,114 @@
+package a10go
+
+// A10TCPTemplate is a TCP template (slb.template.tcp), tuning connection handling of TCP virtual ports
+type A10TCPTemplate struct {
+	Name                 string `json:"name"`
+	IdleTimeout          *int   `json:"idle_timeout,omitempty"`            // seconds
+	HalfCloseIdleTimeout *int   `json:"half_close_idle_timeout,omitempty"` // seconds, IntPtr(0) disables half-close timeout
+	ForceDeleteTimeout   *int   `json:"force_delete_timeout,omitempty"`    // seconds
+	ResetForward         *Flag  `json:"reset_forward,omitempty"`           // send reset to server on idle timeout
+	ResetReceive         *Flag  `json:"reset_receive,omitempty"`           // send reset to client on idle timeout
+	InitialWindowSize    *int   `json:"initial_window_size,omitempty"`     // bytes
+	KeepaliveInterval    *int   `json:"keepalive_interval,omitempty"`      // seconds between keepalive probes
+	KeepaliveProbes      *int   `json:"keepalive_probes,omitempty"`        // unanswered probes before closing
+}
+
+// A10UDPTemplate is a UDP template (slb.template.udp), tuning session handling of UDP virtual ports
+type A10UDPTemplate struct {
+	Name                 string `json:"name"`
+	IdleTimeout          *int   `json:"idle_timeout,omitempty"` // seconds
+	Immediate            *Flag  `json:"immediate,omitempty"`    // age session out right after the response
+	Short                *int   `json:"short,omitempty"`        // seconds to age session out after the response
+	ReselectIfServerDown *Flag  `json:"re_select_if_server_down,omitempty"`
+}
+
+// A10ConnReuseTemplate is a connection-reuse template (slb.template.connection_reuse),
+// keeping server connections open for reuse across client requests
+type A10ConnReuseTemplate struct {
+	Name           string `json:"name"`
+	LimitPerServer *int   `json:"limit_per_server,omitempty"` // max reusable connections per server, IntPtr(0) is unlimited
+	Timeout        *int   `json:"timeout,omitempty"`          // seconds an unused connection is kept open
+	KeepAliveConns *int   `json:"keep_alive_conns,omitempty"` // connections kept open even when idle
+	Preopen        *Flag  `json:"preopen,omitempty"`          // open KeepAliveConns in advance
+}
+
+const (
+	prefixTCP       = "slb.template.tcp"
+	keyTCP          = "tcp_template"
+	prefixUDP       = "slb.template.udp"
+	keyUDP          = "udp_template"
+	prefixConnReuse = "slb.template.connection_reuse"
+	keyConnReuse    = "connection_reuse_template"
+)
+
+// TCPTemplateList retrieves TCP templates
+func (c *Client) TCPTemplateList() []A10TCPTemplate {
+	var list []A10TCPTemplate
+	if err := templateGetAll(c, prefixTCP, keyTCP, &list); err != nil {
+		c.debugf("TCPTemplateList: %v", err)
+	}
+	return list
+}
+
+// TCPTemplateCreate creates TCP template
+func (c *Client) TCPTemplateCreate(t A10TCPTemplate) error {
+	return templatePost(c, prefixTCP, keyTCP, "create", t)
+}
+
+// TCPTemplateUpdate updates TCP template
+func (c *Client) TCPTemplateUpdate(t A10TCPTemplate) error {
+	return templatePost(c, prefixTCP, keyTCP, "update", t)
+}
+
+// TCPTemplateDelete deletes TCP template
+func (c *Client) TCPTemplateDelete(name string) error {
+	return templateDelete(c, prefixTCP, name)
+}
+
+// UDPTemplateList retrieves UDP templates
+func (c *Client) UDPTemplateList() []A10UDPTemplate {
+	var list []A10UDPTemplate
+	if err := templateGetAll(c, prefixUDP, keyUDP, &list); err != nil {
+		c.debugf("UDPTemplateList: %v", err)
+	}
+	return list
+}
+
+// UDPTemplateCreate creates UDP template
+func (c *Client) UDPTemplateCreate(t A10UDPTemplate) error {
+	return templatePost(c, prefixUDP, keyUDP, "create", t)
+}
+
+// UDPTemplateUpdate updates UDP template
+func (c *Client) UDPTemplateUpdate(t A10UDPTemplate) error {
+	return templatePost(c, prefixUDP, keyUDP, "update", t)
+}
+
+// UDPTemplateDelete deletes UDP template
+func (c *Client) UDPTemplateDelete(name string) error {
+	return templateDelete(c, prefixUDP, name)
+}
+
+// ConnReuseTemplateList retrieves connection-reuse templates
+func (c *Client) ConnReuseTemplateList() []A10ConnReuseTemplate {
+	var list []A10ConnReuseTemplate
+	if err := templateGetAll(c, prefixConnReuse, keyConnReuse, &list); err != nil {
+		c.debugf("ConnReuseTemplateList: %v", err)
+	}
+	return list
+}
+
+// ConnReuseTemplateCreate creates connection-reuse template
+func (c *Client) ConnReuseTemplateCreate(t A10ConnReuseTemplate) error {
+	return templatePost(c, prefixConnReuse, keyConnReuse, "create", t)
+}
+
+// ConnReuseTemplateUpdate updates connection-reuse template
+func (c *Client) ConnReuseTemplateUpdate(t A10ConnReuseTemplate) error {
+	return templatePost(c, prefixConnReuse, keyConnReuse, "update", t)
+}
+
+// ConnReuseTemplateDelete deletes connection-reuse template
+func (c *Client) ConnReuseTemplateDelete(name string) error {
+	return templateDelete(c, prefixConnReuse, name)
+}
@@ -0,0 +1,81 @@
+package a10go
+
+import (
+	"sync"
+	"time"
+)
+
+// Limiter bounds the load put on the api host: a token bucket limits the
+// request rate and a semaphore limits the number of requests in flight.
+//
+// A Limiter is safe for concurrent use. Share one Limiter between multiple
+// clients (Options.Limiter) to apply the limits to all of them together,
+// for instance for every client targeting the same host.
+type Limiter struct {
+	rate  float64 // tokens per second, zero means unlimited
+	burst float64 // bucket size
+
+	mutex  sync.Mutex
+	tokens float64
+	last   time.Time
+
+	inFlight chan struct{} // semaphore, nil means unlimited
+}
+
+// NewLimiter creates a limiter allowing rate requests per second with bursts of
+// up to burst requests, and at most maxInFlight concurrent requests.
+// Zero rate or zero maxInFlight disables the respective limit.
+func NewLimiter(rate float64, burst, maxInFlight int) *Limiter {
+	if burst < 1 {
+		burst = 1
+	}
+	l := &Limiter{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+	if maxInFlight > 0 {
+		l.inFlight = make(chan struct{}, maxInFlight)
+	}
+	return l
+}
+
+// acquire blocks until a request may be issued. release must be called when request finishes.
+func (l *Limiter) acquire() {
+	if l == nil {
+		return
+	}
+	if l.inFlight != nil {
+		l.inFlight <- struct{}{}
+	}
+	if l.rate > 0 {
+		time.Sleep(l.reserve())
+	}
+}
+
+func (l *Limiter) release() {
+	if l == nil || l.inFlight == nil {
+		return
+	}
+	<-l.inFlight
+}
+
+// reserve takes one token from the bucket and returns how long to wait for it
+func (l *Limiter) reserve() time.Duration {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+
+	l.tokens-- // may go negative: the debt is paid by waiting
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
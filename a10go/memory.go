@@ -0,0 +1,57 @@
+package a10go
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// WriteMemory saves the running configuration of the active partition to startup configuration
+func (c *Client) WriteMemory() error {
+	return writeMemory(c, "WriteMemory", `{}`)
+}
+
+// WriteMemoryPartition saves the running configuration of an specific partition
+func (c *Client) WriteMemoryPartition(partition string) error {
+	format := `{ "partition": "%s" }`
+	return writeMemory(c, "WriteMemoryPartition", fmt.Sprintf(format, partition))
+}
+
+// WriteMemoryAll saves the running configuration of all partitions
+func (c *Client) WriteMemoryAll() error {
+	return writeMemory(c, "WriteMemoryAll", `{ "partition": "all" }`)
+}
+
+func writeMemory(c *Client, caller, payload string) error {
+	changes := atomic.LoadUint32(&c.unsaved)
+	if err := doPostCheck(c, caller, "system.action.write_memory", payload); err != nil {
+		return err
+	}
+	// changes applied by other goroutines during the save may not be saved, keep them unsaved
+	atomic.CompareAndSwapUint32(&c.unsaved, changes, 0)
+	return nil
+}
+
+// Unsaved reports whether changes were applied through this client since the last
+// successful WriteMemory (or since New). Changes made by other clients or on
+// the device CLI are not detected.
+func (c *Client) Unsaved() bool {
+	return atomic.LoadUint32(&c.unsaved) != 0
+}
+
+// markUnsaved records that running configuration was changed
+func (c *Client) markUnsaved() {
+	if !c.opt.Dry {
+		atomic.AddUint32(&c.unsaved, 1)
+	}
+}
+
+// autoSave calls WriteMemory after successful caller if Options.AutoSave is set
+func autoSave(c *Client, caller string) error {
+	if !c.opt.AutoSave || !c.Unsaved() {
+		return nil
+	}
+	if err := c.WriteMemory(); err != nil {
+		return fmt.Errorf("%s: auto save: %v", caller, err)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package a10go
+
+// NAT pools (nat.pool.*) and pool groups (nat.pool_group.*) follow the same
+// method layout as templates, hence reuse the template helpers.
+
+// A10NATPool is a source NAT pool (nat.pool), referenced by virtual ports as source_nat
+type A10NATPool struct {
+	Name      string `json:"name"`
+	StartAddr string `json:"start_ip_addr,omitempty"`
+	EndAddr   string `json:"end_ip_addr,omitempty"`
+	Netmask   string `json:"netmask,omitempty"`
+	Gateway   string `json:"gateway,omitempty"`
+	HAGroupID *int   `json:"ha_group_id,omitempty"` // VRRP-A group owning the pool, IntPtr(0) is none
+}
+
+// A10NATPoolGroup groups NAT pools (nat.pool_group), referenced by virtual ports as source_nat
+type A10NATPoolGroup struct {
+	Name    string                  `json:"name"`
+	Members []A10NATPoolGroupMember `json:"member_list,omitempty"`
+}
+
+// A10NATPoolGroupMember is a NAT pool within A10NATPoolGroup
+type A10NATPoolGroupMember struct {
+	Pool string `json:"pool_name"`
+}
+
+const (
+	prefixNATPool      = "nat.pool"
+	keyNATPool         = "pool"
+	prefixNATPoolGroup = "nat.pool_group"
+	keyNATPoolGroup    = "pool_group"
+)
+
+// NATPoolList retrieves NAT pools
+func (c *Client) NATPoolList() []A10NATPool {
+	var list []A10NATPool
+	if err := templateGetAll(c, prefixNATPool, keyNATPool, &list); err != nil {
+		c.debugf("NATPoolList: %v", err)
+	}
+	return list
+}
+
+// NATPoolCreate creates NAT pool
+func (c *Client) NATPoolCreate(p A10NATPool) error {
+	return templatePost(c, prefixNATPool, keyNATPool, "create", p)
+}
+
+// NATPoolUpdate updates NAT pool
+func (c *Client) NATPoolUpdate(p A10NATPool) error {
+	return templatePost(c, prefixNATPool, keyNATPool, "update", p)
+}
+
+// NATPoolDelete deletes NAT pool
+func (c *Client) NATPoolDelete(name string) error {
+	return templateDelete(c, prefixNATPool, name)
+}
+
+// NATPoolGroupList retrieves NAT pool groups
+func (c *Client) NATPoolGroupList() []A10NATPoolGroup {
+	var list []A10NATPoolGroup
+	if err := templateGetAll(c, prefixNATPoolGroup, keyNATPoolGroup, &list); err != nil {
+		c.debugf("NATPoolGroupList: %v", err)
+	}
+	return list
+}
+
+// NATPoolGroupCreate creates NAT pool group
+func (c *Client) NATPoolGroupCreate(g A10NATPoolGroup) error {
+	return templatePost(c, prefixNATPoolGroup, keyNATPoolGroup, "create", g)
+}
+
+// NATPoolGroupUpdate updates NAT pool group
+func (c *Client) NATPoolGroupUpdate(g A10NATPoolGroup) error {
+	return templatePost(c, prefixNATPoolGroup, keyNATPoolGroup, "update", g)
+}
+
+// NATPoolGroupDelete deletes NAT pool group
+func (c *Client) NATPoolGroupDelete(name string) error {
+	return templateDelete(c, prefixNATPoolGroup, name)
+}
@@ -0,0 +1,81 @@
+package a10go
+
+import (
+	"fmt"
+)
+
+// A10Partition is a partition for PartitionList()
+type A10Partition struct {
+	Name string
+}
+
+// PartitionList retrieves the partitions visible to the session user
+func (c *Client) PartitionList() []A10Partition {
+	var list []A10Partition
+
+	debugf := c.debugf
+
+	body, errGet := a10SessionGet(c, "system.partition.getAll")
+	if errGet != nil {
+		return list
+	}
+
+	pList := jsonExtractList(debugf, body, "partition_list")
+	for _, p := range pList {
+		pMap, isMap := p.(map[string]interface{})
+		if !isMap {
+			continue
+		}
+		name := mapGetStr(debugf, pMap, "name")
+		debugf("partition: %s", name)
+		list = append(list, A10Partition{Name: name})
+	}
+
+	return list
+}
+
+// SwitchPartition activates partition for the session.
+// Every following call, from any goroutine sharing the Client, is scoped to
+// the partition. The partition is activated again after re-login.
+// SwitchPartition is serialized with Login and re-login, so the recorded
+// partition always matches the one active in the published session.
+func (c *Client) SwitchPartition(name string) error {
+	c.loginMutex.Lock()
+	defer c.loginMutex.Unlock()
+
+	if err := partitionActivate(c, c.session(), name); err != nil {
+		return err
+	}
+	c.mutex.Lock()
+	c.partition = name
+	c.mutex.Unlock()
+	return nil
+}
+
+// Partition returns the active partition, empty for the user default partition
+func (c *Client) Partition() string {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.partition
+}
+
+// partitionActivate activates partition for sessionID.
+// It does not attempt re-login, since it is also used from Login.
+func partitionActivate(c *Client, sessionID, name string) error {
+
+	method := "system.partition.active"
+
+	format := `{ "name": "%s" }`
+	payload := fmt.Sprintf(format, name)
+
+	body, errPost := a10SessionPostOnce(c, method, sessionID, contentTypeJSON, payload, false)
+	if errPost != nil {
+		return fmt.Errorf("partitionActivate: partition=%s error: %v", name, errPost)
+	}
+
+	if badJSONResponse(c.debugf, body) {
+		return fmt.Errorf("partitionActivate: partition=%s bad response: [%s]", name, string(body))
+	}
+
+	return nil
+}
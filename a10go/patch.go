@@ -0,0 +1,349 @@
+package a10go
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Update semantics of aXAPI v2.1:
+//
+// ServerUpdate, ServiceGroupUpdate and VirtualServerUpdate send a full object,
+// but the device merges the given port/member list into the existing one:
+// listed entries are added or modified, unlisted entries are kept. An empty
+// list, however, clears the existing list.
+//
+// The Patch operations below change only the fields that are set, and remove
+// entries only when explicitly asked for. The Replace operations make the
+// device object exactly match the given one, removing unlisted entries.
+
+// ServerPatchSpec specifies changes for ServerPatch
+type ServerPatchSpec struct {
+	Host        *string  // new host, nil keeps current host
+	Template    *string  // new server template, nil keeps current template
+	Ports       []string // ports to add or modify, list of "portName,portProtocol[,template=portTemplate]"
+	RemovePorts []string // ports to remove, list of "portName,portProtocol"
+}
+
+// ServiceGroupPatchSpec specifies changes for ServiceGroupPatch
+type ServiceGroupPatchSpec struct {
+	Protocol      *string  // new protocol, nil keeps current protocol
+	Members       []string // members to add or modify, list of "serverName,portNumber"
+	RemoveMembers []string // members to remove, list of "serverName,portNumber"
+}
+
+// VirtualServerPatchSpec specifies changes for VirtualServerPatch
+type VirtualServerPatchSpec struct {
+	Address            *string  // new address "ipv4[,ipv6]", nil keeps current address
+	Template           *string  // new virtual-server template, nil keeps current template
+	VirtualPorts       []string // virtual ports to add or modify, list of "serviceGroup,port,protocol[,field=value...]"
+	RemoveVirtualPorts []string // virtual ports to remove, list of "port,protocol"
+}
+
+// ServerPatch changes only the server fields set in spec
+func (c *Client) ServerPatch(name string, spec ServerPatchSpec) error {
+
+	me := "ServerPatch"
+
+	for _, p := range spec.RemovePorts {
+		number, proto := splitPortProto(c.debugf, p)
+		if err := serverPortDelete(c, name, number, proto); err != nil {
+			return fmt.Errorf(me+": %v", err)
+		}
+	}
+
+	var fields []string
+	if spec.Host != nil {
+		fields = append(fields, hostFormat(*spec.Host))
+	}
+	if spec.Template != nil {
+		fields = append(fields, fmt.Sprintf(`"template": "%s"`, *spec.Template))
+	}
+	if len(spec.Ports) > 0 {
+		fields = append(fields, fmt.Sprintf(`"port_list": [%s]`, serverPortList(c.debugf, spec.Ports)))
+	}
+	if len(fields) == 0 {
+		return nil // nothing to update
+	}
+
+	payload := fmt.Sprintf(`{ "server": { "name": "%s", %s } }`, name, strings.Join(fields, ", "))
+
+	return doPost(c, me, "slb.server.update", payload)
+}
+
+// ServerReplace makes the server exactly match the given host and ports,
+// removing unlisted ports. The server is created if missing.
+// Server and ports without template are set to the default template.
+// ports is list of "portName,portProtocol[,template=portTemplate]"
+func (c *Client) ServerReplace(name, host string, ports []string) error {
+	return c.ServerReplaceTemplate(name, host, "", ports)
+}
+
+// ServerReplaceTemplate is ServerReplace with server template.
+// An empty template selects the default template.
+func (c *Client) ServerReplaceTemplate(name, host, template string, ports []string) error {
+	list, errList := a10ServerList(c)
+	if errList != nil {
+		return fmt.Errorf("ServerReplace: name=%s: list: %v", name, errList)
+	}
+	current, found := findServer(list, name)
+	if !found {
+		return c.ServerCreateTemplate(name, host, template, ports)
+	}
+	return serverReplace(c, current, host, template, ports)
+}
+
+func serverReplace(c *Client, current A10Server, host, template string, ports []string) error {
+
+	me := "serverReplace"
+
+	want := map[string]bool{}
+	for _, p := range ports {
+		number, proto := splitPortProto(c.debugf, p)
+		want[number+"/"+proto] = true
+	}
+	for _, p := range current.Ports {
+		if want[p.Number+"/"+p.Protocol] {
+			continue // kept or modified by update
+		}
+		if err := serverPortDelete(c, current.Name, p.Number, p.Protocol); err != nil {
+			return fmt.Errorf(me+": %v", err)
+		}
+	}
+
+	// unlisted templates are reset to default, otherwise the device would keep them
+	var exactPorts []A10Port
+	for _, p := range ports {
+		exactPorts = append(exactPorts, parsePort(c.debugf, p))
+	}
+	for i := range exactPorts {
+		if exactPorts[i].Template == "" {
+			exactPorts[i].Template = defaultTemplate
+		}
+	}
+	exactTemplate := template
+	if exactTemplate == "" {
+		exactTemplate = defaultTemplate
+	}
+
+	if err := serverPost(c, "slb.server.update", current.Name, host, exactTemplate, portStrings(exactPorts)); err != nil {
+		return err
+	}
+
+	if c.opt.Dry {
+		return nil
+	}
+
+	list, errList := a10ServerList(c)
+	if errList != nil {
+		return fmt.Errorf(me+": name=%s: verify: %v", current.Name, errList)
+	}
+	s, found := findServer(list, current.Name)
+	if !found || !sameServer(c.debugf, s, host, template, ports) {
+		return fmt.Errorf(me+": name=%s: verify: device does not match: %v", current.Name, s)
+	}
+
+	return nil
+}
+
+func serverPortDelete(c *Client, name, number, proto string) error {
+	format := `{ "name": "%s", "port": %s }`
+	payload := fmt.Sprintf(format, name, portFormat(number, proto))
+	return doPost(c, "serverPortDelete", "slb.server.port.delete", payload)
+}
+
+// ServiceGroupPatch changes only the service group fields set in spec
+func (c *Client) ServiceGroupPatch(name string, spec ServiceGroupPatchSpec) error {
+
+	me := "ServiceGroupPatch"
+
+	for _, m := range spec.RemoveMembers {
+		if err := c.ServiceGroupMemberDelete(name, m); err != nil {
+			return fmt.Errorf(me+": %v", err)
+		}
+	}
+
+	var fields []string
+	if spec.Protocol != nil {
+		fields = append(fields, fmt.Sprintf(`"protocol": %s`, *spec.Protocol))
+	}
+	if len(spec.Members) > 0 {
+		fields = append(fields, fmt.Sprintf(`"member_list": [%s]`, serviceGroupMemberList(c.debugf, spec.Members)))
+	}
+	if len(fields) == 0 {
+		return nil // nothing to update
+	}
+
+	payload := fmt.Sprintf(`{ "service_group": { "name": "%s", %s } }`, name, strings.Join(fields, ", "))
+
+	return doPost(c, me, "slb.service_group.update", payload)
+}
+
+// ServiceGroupReplace makes the service group exactly match the given protocol and members,
+// removing unlisted members. The service group is created if missing.
+// members is list of "serverName,portNumber"
+func (c *Client) ServiceGroupReplace(name, protocol string, members []string) error {
+	list, errList := a10ServiceGroupList(c)
+	if errList != nil {
+		return fmt.Errorf("ServiceGroupReplace: name=%s: list: %v", name, errList)
+	}
+	current, found := findServiceGroup(list, name)
+	if !found {
+		return c.ServiceGroupCreate(name, protocol, members)
+	}
+	return serviceGroupReplace(c, current, protocol, members)
+}
+
+func serviceGroupReplace(c *Client, current A10ServiceGroup, protocol string, members []string) error {
+
+	me := "serviceGroupReplace"
+
+	want := keySet(memberKeys(c.debugf, members))
+	for _, m := range current.Members {
+		if want[m.Name+"/"+m.Port] {
+			continue
+		}
+		if err := c.ServiceGroupMemberDelete(current.Name, m.Name+","+m.Port); err != nil {
+			return fmt.Errorf(me+": %v", err)
+		}
+	}
+
+	if err := c.ServiceGroupUpdate(current.Name, protocol, members); err != nil {
+		return err
+	}
+
+	if c.opt.Dry {
+		return nil
+	}
+
+	list, errList := a10ServiceGroupList(c)
+	if errList != nil {
+		return fmt.Errorf(me+": name=%s: verify: %v", current.Name, errList)
+	}
+	g, found := findServiceGroup(list, current.Name)
+	if !found || g.Protocol != protocol || !equalKeys(sgMemberKeys(g.Members), memberKeys(c.debugf, members)) {
+		return fmt.Errorf(me+": name=%s: verify: device does not match: %v", current.Name, g)
+	}
+
+	return nil
+}
+
+// VirtualServerPatch changes only the virtual server fields set in spec
+func (c *Client) VirtualServerPatch(name string, spec VirtualServerPatchSpec) error {
+
+	me := "VirtualServerPatch"
+
+	for _, p := range spec.RemoveVirtualPorts {
+		port, proto := splitPortProto(c.debugf, p)
+		if err := virtualPortDelete(c, name, port, proto); err != nil {
+			return fmt.Errorf(me+": %v", err)
+		}
+	}
+
+	var fields []string
+	if spec.Address != nil {
+		fields = append(fields, addressFormat(*spec.Address))
+	}
+	if spec.Template != nil {
+		fields = append(fields, fmt.Sprintf(`"vip_template": "%s"`, *spec.Template))
+	}
+	if len(spec.VirtualPorts) > 0 {
+		fields = append(fields, fmt.Sprintf(`"vport_list": [%s]`, virtualPortList(c.debugf, spec.VirtualPorts)))
+	}
+	if len(fields) == 0 {
+		return nil // nothing to update
+	}
+
+	payload := fmt.Sprintf(`{ "virtual_server": { "name": "%s", %s } }`, name, strings.Join(fields, ", "))
+
+	return doPost(c, me, "slb.virtual_server.update", payload)
+}
+
+// VirtualServerReplace makes the virtual server exactly match the given address and virtual ports,
+// removing unlisted virtual ports. The virtual server is created if missing.
+// A virtual server without template is set to the default template, and
+// references not listed for a kept virtual port (templates, source_nat) are cleared.
+// virtualPorts is list of "serviceGroup,port,protocol[,field=value...]"
+func (c *Client) VirtualServerReplace(name, address string, virtualPorts []string) error {
+	return c.VirtualServerReplaceTemplate(name, address, "", virtualPorts)
+}
+
+// VirtualServerReplaceTemplate is VirtualServerReplace with virtual-server template.
+// An empty template selects the default template.
+func (c *Client) VirtualServerReplaceTemplate(name, address, template string, virtualPorts []string) error {
+	list, errList := a10VirtualServerList(c)
+	if errList != nil {
+		return fmt.Errorf("VirtualServerReplace: name=%s: list: %v", name, errList)
+	}
+	current, found := findVirtualServer(list, name)
+	if !found {
+		return c.VirtualServerCreateTemplate(name, address, template, virtualPorts)
+	}
+	return virtualServerReplace(c, current, address, template, virtualPorts)
+}
+
+func virtualServerReplace(c *Client, current A10VServer, address, template string, virtualPorts []string) error {
+
+	me := "virtualServerReplace"
+
+	want := map[string]bool{}
+	for _, p := range virtualPorts {
+		vp := parseVirtualPort(c.debugf, p)
+		want[vp.Port+"/"+vp.Protocol] = true
+	}
+	kept := map[string]A10VirtualPort{}
+	for _, p := range current.VirtualPorts {
+		if want[p.Port+"/"+p.Protocol] {
+			kept[p.Port+"/"+p.Protocol] = p // kept or modified by update
+			continue
+		}
+		if err := virtualPortDelete(c, current.Name, p.Port, p.Protocol); err != nil {
+			return fmt.Errorf(me+": %v", err)
+		}
+	}
+
+	// unlisted template is reset to default, otherwise the device would keep it
+	exactTemplate := template
+	if exactTemplate == "" {
+		exactTemplate = defaultTemplate
+	}
+
+	// unlisted references of kept virtual ports are cleared, for the same reason
+	var exactPorts []string
+	for _, p := range virtualPorts {
+		vp := parseVirtualPort(c.debugf, p)
+		exactPorts = append(exactPorts, virtualPortResetFormat(vp, kept[vp.Port+"/"+vp.Protocol]))
+	}
+
+	if err := virtualServerPostList(c, "slb.virtual_server.update", current.Name, address, exactTemplate, strings.Join(exactPorts, ",")); err != nil {
+		return err
+	}
+
+	if c.opt.Dry {
+		return nil
+	}
+
+	list, errList := a10VirtualServerList(c)
+	if errList != nil {
+		return fmt.Errorf(me+": name=%s: verify: %v", current.Name, errList)
+	}
+	vs, found := findVirtualServer(list, current.Name)
+	if !found || !sameVirtualServer(c.debugf, vs, address, template, virtualPorts) {
+		return fmt.Errorf(me+": name=%s: verify: device does not match: %v", current.Name, vs)
+	}
+
+	return nil
+}
+
+func virtualPortDelete(c *Client, name, port, proto string) error {
+	format := `{ "name": "%s", "vport": {"port": %s, "protocol": %s} }`
+	payload := fmt.Sprintf(format, name, port, proto)
+	return doPost(c, "virtualPortDelete", "slb.virtual_server.vport.delete", payload)
+}
+
+func keySet(keys []string) map[string]bool {
+	set := map[string]bool{}
+	for _, k := range keys {
+		set[k] = true
+	}
+	return set
+}
@@ -0,0 +1,126 @@
+package a10go
+
+// PersistMatchType selects the scope of persistence
+type PersistMatchType int
+
+// Persistence match types
+const (
+	PersistMatchPort         PersistMatchType = 0 // stick to same server port
+	PersistMatchServer       PersistMatchType = 1 // stick to same server, any port
+	PersistMatchServiceGroup PersistMatchType = 2 // stick to same server within service group
+)
+
+// Ptr returns a pointer to t, for optional template fields
+func (t PersistMatchType) Ptr() *PersistMatchType {
+	return &t
+}
+
+// A10CookiePersistenceTemplate is a cookie persistence template (slb.template.cookie_persistence)
+type A10CookiePersistenceTemplate struct {
+	Name         string            `json:"name"`
+	CookieName   string            `json:"cookie_name,omitempty"`
+	Expire       *int              `json:"expire,omitempty"` // cookie expiration in seconds
+	Domain       string            `json:"domain,omitempty"`
+	Path         string            `json:"path,omitempty"`
+	MatchType    *PersistMatchType `json:"match_type,omitempty"`
+	InsertAlways *Flag             `json:"insert_always,omitempty"` // insert cookie in every response
+}
+
+// A10SrcIPPersistenceTemplate is a source-IP persistence template (slb.template.src_ip_persistence)
+type A10SrcIPPersistenceTemplate struct {
+	Name      string            `json:"name"`
+	MatchType *PersistMatchType `json:"match_type,omitempty"`
+	Timeout   *int              `json:"timeout,omitempty"`  // persistence timeout in minutes
+	Netmask   string            `json:"netmask,omitempty"`  // IPv4 mask applied to source address
+	Netmask6  *int              `json:"netmask6,omitempty"` // IPv6 prefix length applied to source address
+}
+
+// A10DstIPPersistenceTemplate is a destination-IP persistence template (slb.template.dst_ip_persistence)
+type A10DstIPPersistenceTemplate struct {
+	Name      string            `json:"name"`
+	MatchType *PersistMatchType `json:"match_type,omitempty"`
+	Timeout   *int              `json:"timeout,omitempty"`  // persistence timeout in minutes
+	Netmask   string            `json:"netmask,omitempty"`  // IPv4 mask applied to destination address
+	Netmask6  *int              `json:"netmask6,omitempty"` // IPv6 prefix length applied to destination address
+}
+
+const (
+	prefixCookiePersist = "slb.template.cookie_persistence"
+	keyCookiePersist    = "cookie_persistence_template"
+	prefixSrcIPPersist  = "slb.template.src_ip_persistence"
+	keySrcIPPersist     = "src_ip_persistence_template"
+	prefixDstIPPersist  = "slb.template.dst_ip_persistence"
+	keyDstIPPersist     = "dst_ip_persistence_template"
+)
+
+// CookiePersistenceTemplateList retrieves cookie persistence templates
+func (c *Client) CookiePersistenceTemplateList() []A10CookiePersistenceTemplate {
+	var list []A10CookiePersistenceTemplate
+	if err := templateGetAll(c, prefixCookiePersist, keyCookiePersist, &list); err != nil {
+		c.debugf("CookiePersistenceTemplateList: %v", err)
+	}
+	return list
+}
+
+// CookiePersistenceTemplateCreate creates cookie persistence template
+func (c *Client) CookiePersistenceTemplateCreate(t A10CookiePersistenceTemplate) error {
+	return templatePost(c, prefixCookiePersist, keyCookiePersist, "create", t)
+}
+
+// CookiePersistenceTemplateUpdate updates cookie persistence template
+func (c *Client) CookiePersistenceTemplateUpdate(t A10CookiePersistenceTemplate) error {
+	return templatePost(c, prefixCookiePersist, keyCookiePersist, "update", t)
+}
+
+// CookiePersistenceTemplateDelete deletes cookie persistence template
+func (c *Client) CookiePersistenceTemplateDelete(name string) error {
+	return templateDelete(c, prefixCookiePersist, name)
+}
+
+// SrcIPPersistenceTemplateList retrieves source-IP persistence templates
+func (c *Client) SrcIPPersistenceTemplateList() []A10SrcIPPersistenceTemplate {
+	var list []A10SrcIPPersistenceTemplate
+	if err := templateGetAll(c, prefixSrcIPPersist, keySrcIPPersist, &list); err != nil {
+		c.debugf("SrcIPPersistenceTemplateList: %v", err)
+	}
+	return list
+}
+
+// SrcIPPersistenceTemplateCreate creates source-IP persistence template
+func (c *Client) SrcIPPersistenceTemplateCreate(t A10SrcIPPersistenceTemplate) error {
+	return templatePost(c, prefixSrcIPPersist, keySrcIPPersist, "create", t)
+}
+
+// SrcIPPersistenceTemplateUpdate updates source-IP persistence template
+func (c *Client) SrcIPPersistenceTemplateUpdate(t A10SrcIPPersistenceTemplate) error {
+	return templatePost(c, prefixSrcIPPersist, keySrcIPPersist, "update", t)
+}
+
+// SrcIPPersistenceTemplateDelete deletes source-IP persistence template
+func (c *Client) SrcIPPersistenceTemplateDelete(name string) error {
+	return templateDelete(c, prefixSrcIPPersist, name)
+}
+
+// DstIPPersistenceTemplateList retrieves destination-IP persistence templates
+func (c *Client) DstIPPersistenceTemplateList() []A10DstIPPersistenceTemplate {
+	var list []A10DstIPPersistenceTemplate
+	if err := templateGetAll(c, prefixDstIPPersist, keyDstIPPersist, &list); err != nil {
+		c.debugf("DstIPPersistenceTemplateList: %v", err)
+	}
+	return list
+}
+
+// DstIPPersistenceTemplateCreate creates destination-IP persistence template
+func (c *Client) DstIPPersistenceTemplateCreate(t A10DstIPPersistenceTemplate) error {
+	return templatePost(c, prefixDstIPPersist, keyDstIPPersist, "create", t)
+}
+
+// DstIPPersistenceTemplateUpdate updates destination-IP persistence template
+func (c *Client) DstIPPersistenceTemplateUpdate(t A10DstIPPersistenceTemplate) error {
+	return templatePost(c, prefixDstIPPersist, keyDstIPPersist, "update", t)
+}
+
+// DstIPPersistenceTemplateDelete deletes destination-IP persistence template
+func (c *Client) DstIPPersistenceTemplateDelete(name string) error {
+	return templateDelete(c, prefixDstIPPersist, name)
+}
@@ -0,0 +1,129 @@
+package a10go
+
+import (
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"time"
+)
+
+// FuncRetryable is function type for deciding whether a failed api call should be retried
+type FuncRetryable func(err error) bool
+
+// RetryPolicy specifies how failed api calls are retried.
+//
+// Reads (api GET) are retried automatically. Writes (api POST) are retried
+// only when RetryWrites is set, since a write may have been applied even if
+// the response was lost.
+type RetryPolicy struct {
+	MaxAttempts    int           // total attempts per call (defaults to 3, use 1 to disable retries)
+	InitialBackoff time.Duration // wait before first retry (defaults to 500ms)
+	MaxBackoff     time.Duration // upper bound for wait between retries (defaults to 10s)
+	Multiplier     float64       // backoff growth factor (defaults to 2)
+	Jitter         float64       // randomize wait by +/- this fraction of backoff (0..1, zero disables jitter, negative selects default 0.2)
+	RetryWrites    bool          // also retry writes (api POST)
+	Retryable      FuncRetryable // custom retryable test (defaults to RetryableError)
+}
+
+const (
+	defaultRetryAttempts   = 3
+	defaultRetryBackoff    = 500 * time.Millisecond
+	defaultRetryMaxBackoff = 10 * time.Second
+	defaultRetryMultiplier = 2
+	defaultRetryJitter     = .2
+)
+
+// RetryableError is the default retryable test: network errors and http 5xx status
+func RetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode >= 500
+	}
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+func (p RetryPolicy) attempts() int {
+	if p.MaxAttempts < 1 {
+		return defaultRetryAttempts
+	}
+	return p.MaxAttempts
+}
+
+func (p RetryPolicy) retryable(err error) bool {
+	if p.Retryable != nil {
+		return p.Retryable(err)
+	}
+	return RetryableError(err)
+}
+
+// backoff returns wait before retry number n (starting at 1)
+func (p RetryPolicy) backoff(n int) time.Duration {
+	initial := p.InitialBackoff
+	if initial <= 0 {
+		initial = defaultRetryBackoff
+	}
+	max := p.MaxBackoff
+	if max <= 0 {
+		max = defaultRetryMaxBackoff
+	}
+	mult := p.Multiplier
+	if mult < 1 {
+		mult = defaultRetryMultiplier
+	}
+	jitter := p.Jitter
+	if jitter < 0 {
+		jitter = defaultRetryJitter
+	}
+	if jitter > 1 {
+		jitter = 1
+	}
+
+	wait := float64(initial)
+	for i := 1; i < n && wait < float64(max); i++ {
+		wait *= mult
+	}
+	if wait > float64(max) {
+		wait = float64(max)
+	}
+	wait += wait * jitter * (2*rand.Float64() - 1)
+
+	return time.Duration(wait)
+}
+
+// retryCall invokes call according to client retry policy.
+// Every attempt is subject to client limiter.
+// write tells whether call is a write (api POST), retried only if policy allows it.
+func retryCall(c *Client, method string, write bool, call func() ([]byte, error)) ([]byte, error) {
+	p := c.opt.Retry
+	attempts := p.attempts()
+	if write && !p.RetryWrites {
+		attempts = 1
+	}
+
+	var body []byte
+	var err error
+
+	for n := 1; ; n++ {
+		c.opt.Limiter.acquire()
+		body, err = call()
+		c.opt.Limiter.release()
+		if err == nil || n >= attempts || !p.retryable(err) {
+			return body, err
+		}
+		wait := p.backoff(n)
+		c.debugf("retryCall: method=%s attempt=%d/%d error: %v - retrying in %v", method, n, attempts, err, wait)
+		time.Sleep(wait)
+	}
+}
@@ -0,0 +1,82 @@
+package a10go
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryBackoff(t *testing.T) {
+	table := []struct {
+		name   string
+		jitter float64
+		n      int
+		wait   time.Duration // expected wait before jitter
+	}{
+		{"first retry", 0, 1, 100 * time.Millisecond},
+		{"second retry", 0, 2, 200 * time.Millisecond},
+		{"fourth retry", 0, 4, 800 * time.Millisecond},
+		{"max backoff", 0, 5, time.Second},
+		{"default jitter", -1, 1, 100 * time.Millisecond},
+		{"default jitter max backoff", -1, 10, time.Second},
+	}
+
+	for _, data := range table {
+		p := RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second, Multiplier: 2, Jitter: data.jitter}
+		for i := 0; i < 100; i++ {
+			wait := p.backoff(data.n)
+			if data.jitter == 0 {
+				if wait != data.wait {
+					t.Errorf("%s: n=%d expected wait=%v got %v", data.name, data.n, data.wait, wait)
+				}
+				continue
+			}
+			// negative jitter selects default: +/- 20%
+			min := time.Duration(float64(data.wait) * (1 - defaultRetryJitter))
+			max := time.Duration(float64(data.wait) * (1 + defaultRetryJitter))
+			if wait < min || wait > max {
+				t.Errorf("%s: n=%d expected wait in [%v,%v] got %v", data.name, data.n, min, max, wait)
+			}
+		}
+	}
+}
+
+func TestRetryCall(t *testing.T) {
+	var attempts int32
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+
+	host := strings.TrimPrefix(ts.URL, "https://")
+
+	table := []struct {
+		name        string
+		retryWrites bool
+		call        func(c *Client) error
+		attempts    int32
+	}{
+		{"read", false, func(c *Client) error { _, err := c.Get("slb.server.getAll"); return err }, 3},
+		{"read post", false, func(c *Client) error { _, err := a10SessionPostRead(c, "slb.aflex.download", "{}"); return err }, 3},
+		{"write", false, func(c *Client) error { _, err := c.Post("slb.server.create", "{}"); return err }, 1},
+		{"write with RetryWrites", true, func(c *Client) error { _, err := c.Post("slb.server.create", "{}"); return err }, 3},
+	}
+
+	for _, data := range table {
+		c := New(host, Options{
+			TLS:   TLSOptions{InsecureSkipVerify: true},
+			Retry: RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, Jitter: 0, RetryWrites: data.retryWrites},
+		})
+		atomic.StoreInt32(&attempts, 0)
+		if err := data.call(c); err == nil {
+			t.Errorf("%s: unexpected success", data.name)
+		}
+		if got := atomic.LoadInt32(&attempts); got != data.attempts {
+			t.Errorf("%s: expected attempts=%d got %d", data.name, data.attempts, got)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package a10go
+
+// A10ServerTemplate is a server template (slb.template.server), applied to real servers
+type A10ServerTemplate struct {
+	Name                string `json:"name"`
+	HealthMonitor       string `json:"health_monitor,omitempty"`
+	ConnLimit           *int   `json:"conn_limit,omitempty"`
+	ConnResume          *int   `json:"conn_resume,omitempty"` // connections below which server accepts new ones again
+	Weight              *int   `json:"weight,omitempty"`
+	SlowStart           *Flag  `json:"slow_start,omitempty"`            // ramp up connections to a server coming up
+	SlowStartInitConn   *int   `json:"slow_start_init_conn,omitempty"`  // initial connection limit
+	SlowStartAdd        *int   `json:"slow_start_add,omitempty"`        // connections added every SlowStartEvery
+	SlowStartEvery      *int   `json:"slow_start_every,omitempty"`      // seconds
+	SlowStartTill       *int   `json:"slow_start_till,omitempty"`       // connection limit ending slow start
+	DNSQueryInterval    *int   `json:"dns_query_interval,omitempty"`    // minutes between resolutions of server hostname
+	DynamicServerPrefix string `json:"dynamic_server_prefix,omitempty"` // name prefix for servers created from DNS answers
+	MaxDynamicServer    *int   `json:"max_dynamic_server,omitempty"`    // max servers created from DNS answers
+}
+
+// A10PortTemplate is a port template (slb.template.port), applied to real server ports
+type A10PortTemplate struct {
+	Name              string `json:"name"`
+	HealthMonitor     string `json:"health_monitor,omitempty"`
+	ConnLimit         *int   `json:"conn_limit,omitempty"`
+	ConnResume        *int   `json:"conn_resume,omitempty"`
+	ConnRateLimit     *int   `json:"conn_rate_limit,omitempty"` // new connections per second
+	Weight            *int   `json:"weight,omitempty"`
+	SlowStart         *Flag  `json:"slow_start,omitempty"`
+	SlowStartInitConn *int   `json:"slow_start_init_conn,omitempty"`
+	SlowStartAdd      *int   `json:"slow_start_add,omitempty"`
+	SlowStartEvery    *int   `json:"slow_start_every,omitempty"` // seconds
+	SlowStartTill     *int   `json:"slow_start_till,omitempty"`
+}
+
+const (
+	prefixServerTemplate = "slb.template.server"
+	keyServerTemplate    = "server_template"
+	prefixPortTemplate   = "slb.template.port"
+	keyPortTemplate      = "port_template"
+)
+
+// ServerTemplateList retrieves server templates
+func (c *Client) ServerTemplateList() []A10ServerTemplate {
+	var list []A10ServerTemplate
+	if err := templateGetAll(c, prefixServerTemplate, keyServerTemplate, &list); err != nil {
+		c.debugf("ServerTemplateList: %v", err)
+	}
+	return list
+}
+
+// ServerTemplateCreate creates server template
+func (c *Client) ServerTemplateCreate(t A10ServerTemplate) error {
+	return templatePost(c, prefixServerTemplate, keyServerTemplate, "create", t)
+}
+
+// ServerTemplateUpdate updates server template
+func (c *Client) ServerTemplateUpdate(t A10ServerTemplate) error {
+	return templatePost(c, prefixServerTemplate, keyServerTemplate, "update", t)
+}
+
+// ServerTemplateDelete deletes server template
+func (c *Client) ServerTemplateDelete(name string) error {
+	return templateDelete(c, prefixServerTemplate, name)
+}
+
+// PortTemplateList retrieves port templates
+func (c *Client) PortTemplateList() []A10PortTemplate {
+	var list []A10PortTemplate
+	if err := templateGetAll(c, prefixPortTemplate, keyPortTemplate, &list); err != nil {
+		c.debugf("PortTemplateList: %v", err)
+	}
+	return list
+}
+
+// PortTemplateCreate creates port template
+func (c *Client) PortTemplateCreate(t A10PortTemplate) error {
+	return templatePost(c, prefixPortTemplate, keyPortTemplate, "create", t)
+}
+
+// PortTemplateUpdate updates port template
+func (c *Client) PortTemplateUpdate(t A10PortTemplate) error {
+	return templatePost(c, prefixPortTemplate, keyPortTemplate, "update", t)
+}
+
+// PortTemplateDelete deletes port template
+func (c *Client) PortTemplateDelete(name string) error {
+	return templateDelete(c, prefixPortTemplate, name)
+}
@@ -0,0 +1,216 @@
+package a10go
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeDevice is a minimal aXAPI v2.1 server tracking sessions
+type fakeDevice struct {
+	mutex    sync.Mutex
+	sessions map[string]string // valid session ids mapped to active partition
+	logins   int
+	delay    time.Duration     // authentication latency, widens login races
+	fail     map[string]bool   // methods answered with api failure
+	calls    []fakeCall        // session calls received
+	replies  map[string]string // canned responses by method
+}
+
+// fakeCall is an api call received by fakeDevice
+type fakeCall struct {
+	method string
+	body   string
+}
+
+func (d *fakeDevice) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	method := q.Get("method")
+
+	if method == "authenticate" {
+		d.mutex.Lock()
+		delay := d.delay
+		d.mutex.Unlock()
+		time.Sleep(delay)
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if method == "authenticate" {
+		d.logins++
+		id := fmt.Sprintf("session%d", d.logins)
+		d.sessions[id] = ""
+		fmt.Fprintf(w, `{"session_id": "%s"}`, id)
+		return
+	}
+
+	sessionID := q.Get("session_id")
+	if _, valid := d.sessions[sessionID]; !valid {
+		fmt.Fprint(w, `{"response": {"status": "fail", "err": {"code": 1009, "msg": "Invalid session ID"}}}`)
+		return
+	}
+
+	body, _ := ioutil.ReadAll(r.Body)
+	d.calls = append(d.calls, fakeCall{method: method, body: string(body)})
+
+	if d.fail[method] {
+		fmt.Fprint(w, `{"response": {"status": "fail", "err": {"code": 1, "msg": "forced failure"}}}`)
+		return
+	}
+
+	if reply, found := d.replies[method]; found {
+		fmt.Fprint(w, reply)
+		return
+	}
+
+	switch method {
+	case "system.partition.active":
+		var p struct{ Name string }
+		if err := json.Unmarshal(body, &p); err != nil {
+			fmt.Fprint(w, `{"response": {"status": "fail", "err": {"code": 1, "msg": "bad partition"}}}`)
+			return
+		}
+		d.sessions[sessionID] = p.Name
+		fmt.Fprint(w, `{"response": {"status": "OK"}}`)
+	case "slb.server.getAll":
+		fmt.Fprint(w, `{"server_list": [{"name": "s1", "host": "10.0.0.1", "port_list": [{"port_num": 80, "protocol": 2}]}]}`)
+	default:
+		fmt.Fprint(w, `{"response": {"status": "OK"}}`)
+	}
+}
+
+// expire invalidates every session
+func (d *fakeDevice) expire() {
+	d.mutex.Lock()
+	d.sessions = map[string]string{}
+	d.mutex.Unlock()
+}
+
+// activePartition returns the partition active for session id
+func (d *fakeDevice) activePartition(id string) string {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	return d.sessions[id]
+}
+
+// failMethod makes the device reject method
+func (d *fakeDevice) failMethod(method string) {
+	d.mutex.Lock()
+	d.fail[method] = true
+	d.mutex.Unlock()
+}
+
+// reply makes the device answer method with body
+func (d *fakeDevice) reply(method, body string) {
+	d.mutex.Lock()
+	d.replies[method] = body
+	d.mutex.Unlock()
+}
+
+// received returns the session calls received so far
+func (d *fakeDevice) received() []fakeCall {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	return append([]fakeCall(nil), d.calls...)
+}
+
+func (d *fakeDevice) loginCount() int {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	return d.logins
+}
+
+func newFakeClient(t *testing.T) (*Client, *fakeDevice, func()) {
+	d := &fakeDevice{sessions: map[string]string{}, fail: map[string]bool{}, replies: map[string]string{}}
+	ts := httptest.NewTLSServer(d)
+	host := strings.TrimPrefix(ts.URL, "https://")
+	c := New(host, Options{TLS: TLSOptions{InsecureSkipVerify: true}})
+	if err := c.Login("admin", "a10"); err != nil {
+		ts.Close()
+		t.Fatalf("login: %v", err)
+	}
+	return c, d, ts.Close
+}
+
+func TestConcurrentRelogin(t *testing.T) {
+	c, d, done := newFakeClient(t)
+	defer done()
+
+	d.expire()
+
+	const callers = 20
+	var wg sync.WaitGroup
+	errs := make(chan error, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			list, err := a10ServerList(c)
+			if err == nil && len(list) != 1 {
+				err = fmt.Errorf("bad list: %v", list)
+			}
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("call: %v", err)
+		}
+	}
+
+	if logins := d.loginCount(); logins != 2 {
+		t.Errorf("expected single re-login (2 logins), got %d logins", logins)
+	}
+}
+
+func TestLoginSwitchPartitionRace(t *testing.T) {
+	c, d, done := newFakeClient(t)
+	defer done()
+
+	d.mutex.Lock()
+	d.delay = 5 * time.Millisecond
+	d.mutex.Unlock()
+
+	for i := 0; i < 10; i++ {
+		var wg sync.WaitGroup
+		wg.Add(3)
+		go func() {
+			defer wg.Done()
+			if err := c.Login("admin", "a10"); err != nil {
+				t.Errorf("login: %v", err)
+			}
+		}()
+		go func(i int) {
+			defer wg.Done()
+			time.Sleep(time.Millisecond) // switch while login authenticates
+			if err := c.SwitchPartition(fmt.Sprintf("p%d", i)); err != nil {
+				t.Errorf("switch partition: %v", err)
+			}
+		}(i)
+		go func() {
+			defer wg.Done()
+			if _, err := a10ServerList(c); err != nil {
+				t.Errorf("call: %v", err)
+			}
+		}()
+		wg.Wait()
+
+		partition := c.Partition()
+		if partition == "" {
+			t.Errorf("partition not recorded")
+		}
+		if active := d.activePartition(c.session()); active != partition {
+			t.Errorf("round %d: session partition=%q recorded partition=%q", i, active, partition)
+		}
+	}
+}
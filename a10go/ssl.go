@@ -0,0 +1,218 @@
+package a10go
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// A10SSLCert is an SSL certificate for SSLCertList() and SSLCertUpload()
+type A10SSLCert struct {
+	Name     string
+	Subject  string   // subject common name, as reported by the device list
+	Issuer   string   // issuer common name
+	SANs     []string // subject alternative names, filled by SSLCertGet and SSLCertUpload only
+	NotAfter time.Time
+	HasKey   bool // private key is installed
+}
+
+// SSLCertList retrieves SSL certificates installed on the device.
+// The device list does not report subject alternative names, hence SANs is empty.
+// Use SSLCertGet to retrieve them.
+func (c *Client) SSLCertList() []A10SSLCert {
+	list, _ := sslCertList(c)
+	return list
+}
+
+// SSLCertGet downloads and parses SSL certificate, including its subject alternative names
+func (c *Client) SSLCertGet(name string) (A10SSLCert, error) {
+
+	me := "SSLCertGet"
+
+	list, errList := sslCertList(c)
+	if errList != nil {
+		return A10SSLCert{Name: name}, fmt.Errorf(me+": cert=%s: list: %v", name, errList)
+	}
+	var hasKey, found bool
+	for _, cert := range list {
+		if cert.Name == name {
+			hasKey, found = cert.HasKey, true
+			break
+		}
+	}
+	if !found {
+		return A10SSLCert{Name: name}, fmt.Errorf(me+": cert=%s: not found", name)
+	}
+
+	payload := fmt.Sprintf(`{ "file_name": "%s", "type": "cert" }`, name)
+
+	body, errPost := a10SessionPostRead(c, "slb.ssl.download", payload)
+	if errPost != nil {
+		return A10SSLCert{Name: name}, fmt.Errorf(me+": cert=%s error: %v", name, errPost)
+	}
+
+	// failures are reported as json, while the certificate is PEM text
+	if trimmed := bytes.TrimSpace(body); len(trimmed) > 0 && trimmed[0] == '{' {
+		if errAPI := apiFailure(body); errAPI != nil {
+			return A10SSLCert{Name: name}, fmt.Errorf(me+": cert=%s: %v", name, errAPI)
+		}
+	}
+
+	cert, errParse := parseCertPEM(name, body)
+	cert.HasKey = hasKey
+
+	return cert, errParse
+}
+
+func sslCertList(c *Client) ([]A10SSLCert, error) {
+	var list []A10SSLCert
+
+	debugf := c.debugf
+
+	body, errGet := a10SessionGet(c, "slb.ssl.getAll")
+	if errGet != nil {
+		return list, errGet
+	}
+
+	certList := jsonExtractList(debugf, body, "ssl_cert_list")
+	if certList == nil {
+		return list, apiFailure(body)
+	}
+
+	for _, i := range certList {
+		cMap, isMap := i.(map[string]interface{})
+		if !isMap {
+			continue
+		}
+
+		cert := A10SSLCert{
+			Name:    mapGetStr(debugf, cMap, "file_name"),
+			Subject: mapGetStr(debugf, cMap, "common_name"),
+			Issuer:  mapGetStr(debugf, cMap, "issuer"),
+			HasKey:  mapGetValue(debugf, cMap, "key") == "1",
+		}
+
+		expiration := mapGetStr(debugf, cMap, "expiration_date")
+		notAfter, errTime := parseCertTime(expiration)
+		if errTime != nil {
+			debugf("ssl cert: %s expiration unknown: %v", cert.Name, errTime)
+		}
+		cert.NotAfter = notAfter
+
+		debugf("ssl cert: %s notAfter=%v", cert.Name, cert.NotAfter)
+
+		list = append(list, cert)
+	}
+
+	return list, nil
+}
+
+// certTimeLayouts are the expiration date formats reported by devices
+var certTimeLayouts = []string{
+	"Jan _2 15:04:05 2006 MST",
+	"Jan _2 15:04:05 2006",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+	time.RFC3339,
+}
+
+func parseCertTime(s string) (time.Time, error) {
+	s = strings.Join(strings.Fields(s), " ")
+	for _, layout := range certTimeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("parseCertTime: unknown date format: [%s]", s)
+}
+
+// SSLCertUpload installs certificate certPEM under name, and its private key keyPEM if not empty.
+// passphrase decrypts keyPEM, if encrypted. Certificate metadata is parsed from certPEM.
+func (c *Client) SSLCertUpload(name string, certPEM, keyPEM []byte, passphrase string) (A10SSLCert, error) {
+
+	cert, errParse := parseCertPEM(name, certPEM)
+	if errParse != nil {
+		return cert, errParse
+	}
+
+	if err := sslUpload(c, "cert", name, certPEM, ""); err != nil {
+		return cert, err
+	}
+
+	if len(keyPEM) > 0 {
+		if err := sslUpload(c, "key", name, keyPEM, passphrase); err != nil {
+			return cert, err
+		}
+		cert.HasKey = true
+	}
+
+	return cert, nil
+}
+
+// SSLCertDelete deletes certificate and its private key
+func (c *Client) SSLCertDelete(name string) error {
+	format := `{ "file_name": "%s", "cert_type": "%s" }`
+	if err := doPost(c, "SSLCertDelete", "slb.ssl.delete", fmt.Sprintf(format, name, "cert")); err != nil {
+		return err
+	}
+	// the certificate may have no key, hence ignore key deletion error
+	if err := doPost(c, "SSLCertDelete", "slb.ssl.delete", fmt.Sprintf(format, name, "key")); err != nil {
+		c.debugf("SSLCertDelete: cert=%s: key: %v", name, err)
+	}
+	return nil
+}
+
+func parseCertPEM(name string, certPEM []byte) (A10SSLCert, error) {
+	cert := A10SSLCert{Name: name}
+
+	block, _ := pem.Decode(certPEM)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return cert, fmt.Errorf("parseCertPEM: cert=%s: no PEM certificate found", name)
+	}
+
+	x, errX509 := x509.ParseCertificate(block.Bytes)
+	if errX509 != nil {
+		return cert, fmt.Errorf("parseCertPEM: cert=%s: %v", name, errX509)
+	}
+
+	cert.Subject = x.Subject.CommonName // same form as the device list common_name
+	cert.Issuer = x.Issuer.CommonName
+	cert.NotAfter = x.NotAfter
+	cert.SANs = append(cert.SANs, x.DNSNames...)
+	for _, ip := range x.IPAddresses {
+		cert.SANs = append(cert.SANs, ip.String())
+	}
+
+	return cert, nil
+}
+
+// sslUpload uploads file content. fileType is "cert" or "key".
+func sslUpload(c *Client, fileType, name string, content []byte, passphrase string) error {
+
+	me := "sslUpload"
+	method := "slb.ssl.upload"
+
+	fields := map[string]string{"type": fileType, "file_name": name}
+	if passphrase != "" {
+		fields["pass_phrase"] = passphrase
+	}
+
+	body, errPost := multipartUpload(c, method, fields, name+"."+fileType, content)
+
+	c.debugf(me+": type=%s name=%s size=%d respBody=[%s] error=[%v]", fileType, name, len(content), body, errPost)
+
+	if errPost != nil {
+		return fmt.Errorf(me+": type=%s name=%s error: %v", fileType, name, errPost)
+	}
+
+	if badJSONResponse(c.debugf, body) {
+		return fmt.Errorf(me+": type=%s name=%s bad response: [%s]", fileType, name, string(body))
+	}
+
+	c.markUnsaved()
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package a10go
+
+// A10ClientSSLTemplate is a client-SSL template (slb.template.client_ssl), used by
+// HTTPS virtual ports to terminate SSL from clients.
+type A10ClientSSLTemplate struct {
+	Name                string   `json:"name"`
+	CertName            string   `json:"cert_name,omitempty"`       // certificate uploaded by SSLCertUpload
+	KeyName             string   `json:"key_name,omitempty"`        // private key uploaded by SSLCertUpload
+	PassPhrase          string   `json:"pass_phrase,omitempty"`     // private key passphrase
+	ChainCertName       string   `json:"chain_cert_name,omitempty"` // intermediate CA chain
+	CipherList          []string `json:"cipher_list,omitempty"`     // enabled ciphers, e.g. "TLS1_RSA_AES_128_SHA"
+	DisableSSLv3        *Flag    `json:"disable_sslv3,omitempty"`
+	DisableTLSv10       *Flag    `json:"disable_tlsv1_0,omitempty"`
+	DisableTLSv11       *Flag    `json:"disable_tlsv1_1,omitempty"`
+	SessionCacheSize    *int     `json:"session_cache_size,omitempty"`    // cached sessions, IntPtr(0) disables cache
+	SessionCacheTimeout *int     `json:"session_cache_timeout,omitempty"` // seconds
+}
+
+// A10ServerSSLTemplate is a server-SSL template (slb.template.server_ssl), used by
+// virtual ports to re-encrypt traffic towards the real servers.
+type A10ServerSSLTemplate struct {
+	Name                string   `json:"name"`
+	CertName            string   `json:"cert_name,omitempty"`    // client certificate presented to servers
+	KeyName             string   `json:"key_name,omitempty"`     // private key for CertName
+	PassPhrase          string   `json:"pass_phrase,omitempty"`  // private key passphrase
+	CACertName          string   `json:"ca_cert_name,omitempty"` // CA used to verify server certificates
+	CipherList          []string `json:"cipher_list,omitempty"`
+	DisableSSLv3        *Flag    `json:"disable_sslv3,omitempty"`
+	DisableTLSv10       *Flag    `json:"disable_tlsv1_0,omitempty"`
+	DisableTLSv11       *Flag    `json:"disable_tlsv1_1,omitempty"`
+	SessionCacheSize    *int     `json:"session_cache_size,omitempty"`
+	SessionCacheTimeout *int     `json:"session_cache_timeout,omitempty"` // seconds
+}
+
+const (
+	prefixClientSSL = "slb.template.client_ssl"
+	keyClientSSL    = "client_ssl_template"
+	prefixServerSSL = "slb.template.server_ssl"
+	keyServerSSL    = "server_ssl_template"
+)
+
+// ClientSSLTemplateList retrieves client-SSL templates
+func (c *Client) ClientSSLTemplateList() []A10ClientSSLTemplate {
+	var list []A10ClientSSLTemplate
+	if err := templateGetAll(c, prefixClientSSL, keyClientSSL, &list); err != nil {
+		c.debugf("ClientSSLTemplateList: %v", err)
+	}
+	return list
+}
+
+// ClientSSLTemplateCreate creates client-SSL template
+func (c *Client) ClientSSLTemplateCreate(t A10ClientSSLTemplate) error {
+	return templatePost(c, prefixClientSSL, keyClientSSL, "create", t)
+}
+
+// ClientSSLTemplateUpdate updates client-SSL template
+func (c *Client) ClientSSLTemplateUpdate(t A10ClientSSLTemplate) error {
+	return templatePost(c, prefixClientSSL, keyClientSSL, "update", t)
+}
+
+// ClientSSLTemplateDelete deletes client-SSL template
+func (c *Client) ClientSSLTemplateDelete(name string) error {
+	return templateDelete(c, prefixClientSSL, name)
+}
+
+// ServerSSLTemplateList retrieves server-SSL templates
+func (c *Client) ServerSSLTemplateList() []A10ServerSSLTemplate {
+	var list []A10ServerSSLTemplate
+	if err := templateGetAll(c, prefixServerSSL, keyServerSSL, &list); err != nil {
+		c.debugf("ServerSSLTemplateList: %v", err)
+	}
+	return list
+}
+
+// ServerSSLTemplateCreate creates server-SSL template
+func (c *Client) ServerSSLTemplateCreate(t A10ServerSSLTemplate) error {
+	return templatePost(c, prefixServerSSL, keyServerSSL, "create", t)
+}
+
+// ServerSSLTemplateUpdate updates server-SSL template
+func (c *Client) ServerSSLTemplateUpdate(t A10ServerSSLTemplate) error {
+	return templatePost(c, prefixServerSSL, keyServerSSL, "update", t)
+}
+
+// ServerSSLTemplateDelete deletes server-SSL template
+func (c *Client) ServerSSLTemplateDelete(name string) error {
+	return templateDelete(c, prefixServerSSL, name)
+}
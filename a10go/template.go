@@ -0,0 +1,100 @@
+package a10go
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Templates (slb.template.*) share the same aXAPI v2.1 method layout:
+//
+//	<prefix>.getAll  -> {"<key>_list": [ {...}, ... ]}
+//	<prefix>.create  <- {"<key>": {...}}
+//	<prefix>.update  <- {"<key>": {...}}
+//	<prefix>.delete  <- {"name": "..."}
+//
+// Template types are plain structs with json tags matching aXAPI fields.
+// Omitted fields keep the current device value on update (the device default
+// on create). Flags, enumerations and numbers are pointers, thus false or zero
+// values can still be sent explicitly:
+//
+//	t.InsertAlways = Flag(false).Ptr()
+//	t.MatchType = PersistMatchPort.Ptr()
+//	t.Expire = IntPtr(0)
+//
+// String fields are omitted when empty.
+
+// Flag is a boolean encoded by aXAPI as 0/1
+type Flag bool
+
+// MarshalJSON encodes Flag as 0/1
+func (f Flag) MarshalJSON() ([]byte, error) {
+	if f {
+		return []byte("1"), nil
+	}
+	return []byte("0"), nil
+}
+
+// IntPtr returns a pointer to i, for optional template fields
+func IntPtr(i int) *int {
+	return &i
+}
+
+// Ptr returns a pointer to f, for optional template fields
+func (f Flag) Ptr() *Flag {
+	return &f
+}
+
+// UnmarshalJSON decodes Flag from 0/1 or true/false
+func (f *Flag) UnmarshalJSON(b []byte) error {
+	switch string(b) {
+	case "1", "true", `"1"`:
+		*f = true
+	case "0", "false", `"0"`, "null":
+		*f = false
+	default:
+		return fmt.Errorf("flag: bad value: %s", string(b))
+	}
+	return nil
+}
+
+// templateGetAll decodes template list from <prefix>.getAll into list (pointer to slice)
+func templateGetAll(c *Client, prefix, key string, list interface{}) error {
+	body, errGet := a10SessionGet(c, prefix+".getAll")
+	if errGet != nil {
+		return errGet
+	}
+	return jsonDecodeList(body, key+"_list", list)
+}
+
+// jsonDecodeList decodes field listName of api response into list (pointer to slice)
+func jsonDecodeList(body []byte, listName string, list interface{}) error {
+	tab := map[string]json.RawMessage{}
+	if errJSON := json.Unmarshal(body, &tab); errJSON != nil {
+		return fmt.Errorf("jsonDecodeList: list=%s json error: %v", listName, errJSON)
+	}
+	raw, found := tab[listName]
+	if !found {
+		return apiFailure(body) // missing list is fine unless api reported failure
+	}
+	if errList := json.Unmarshal(raw, list); errList != nil {
+		return fmt.Errorf("jsonDecodeList: list=%s: %v", listName, errList)
+	}
+	return nil
+}
+
+// templatePost sends template t as {"<key>": t} to <prefix>.<action>
+func templatePost(c *Client, prefix, key, action string, t interface{}) error {
+	me := "templatePost"
+	payload, errJSON := json.Marshal(map[string]interface{}{key: t})
+	if errJSON != nil {
+		return fmt.Errorf(me+": %s.%s: %v", prefix, action, errJSON)
+	}
+	return doPost(c, me, prefix+"."+action, string(payload))
+}
+
+// templateDelete deletes template name with <prefix>.delete
+func templateDelete(c *Client, prefix, name string) error {
+	format := `{ "name": "%s" }`
+	payload := fmt.Sprintf(format, name)
+	return doPost(c, "templateDelete", prefix+".delete", payload)
+}
@@ -0,0 +1,106 @@
+package a10go
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"strings"
+)
+
+// TLSOptions specify how the api client verifies the A10 device certificate.
+//
+// By default the device certificate is verified against the system root CAs.
+// Devices using self-signed certificates require either a custom CA (CAFile/CAPool),
+// certificate pinning (PinSHA256), or the explicit opt-in InsecureSkipVerify.
+//
+// When PinSHA256 is given without a custom CA, the pin replaces chain verification:
+// the device leaf certificate is accepted only if its fingerprint is pinned.
+// When PinSHA256 is given with a custom CA, both the chain and the pin are verified.
+type TLSOptions struct {
+	CAFile             string            // PEM file with CA certificates used to verify the device
+	CAPool             *x509.CertPool    // CA pool used to verify the device (merged with CAFile)
+	ServerName         string            // override server name used for verification and SNI
+	Certificates       []tls.Certificate // client certificates presented to the device
+	MinVersion         uint16            // minimum TLS version (defaults to tls.VersionTLS12)
+	PinSHA256          []string          // accepted SHA-256 fingerprints (hex, colons optional) of the device leaf certificate
+	InsecureSkipVerify bool              // disable chain and host name verification (pins are still enforced)
+}
+
+func tlsConfig(opt TLSOptions) (*tls.Config, error) {
+
+	conf := &tls.Config{
+		ServerName:         opt.ServerName,
+		Certificates:       opt.Certificates,
+		MinVersion:         opt.MinVersion,
+		InsecureSkipVerify: opt.InsecureSkipVerify,
+	}
+
+	if conf.MinVersion == 0 {
+		conf.MinVersion = tls.VersionTLS12
+	}
+
+	pool := opt.CAPool
+	if opt.CAFile != "" {
+		pem, errRead := ioutil.ReadFile(opt.CAFile)
+		if errRead != nil {
+			return nil, fmt.Errorf("tlsConfig: read CA file=%s: %v", opt.CAFile, errRead)
+		}
+		if pool == nil {
+			pool = x509.NewCertPool()
+		} else {
+			pool = pool.Clone()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("tlsConfig: CA file=%s: no PEM certificate found", opt.CAFile)
+		}
+	}
+	conf.RootCAs = pool
+
+	if len(opt.PinSHA256) > 0 {
+		if pool == nil {
+			// the pin replaces chain verification, otherwise
+			// self-signed certificates would fail before verifyPin runs
+			conf.InsecureSkipVerify = true
+		}
+		pins := map[string]bool{}
+		for _, p := range opt.PinSHA256 {
+			pin, errPin := parsePin(p)
+			if errPin != nil {
+				return nil, errPin
+			}
+			pins[pin] = true
+		}
+		conf.VerifyConnection = func(cs tls.ConnectionState) error {
+			return verifyPin(pins, cs)
+		}
+	}
+
+	return conf, nil
+}
+
+func parsePin(pin string) (string, error) {
+	p := strings.ToLower(strings.Replace(strings.TrimSpace(pin), ":", "", -1))
+	raw, errHex := hex.DecodeString(p)
+	if errHex != nil {
+		return "", fmt.Errorf("parsePin: bad pin=[%s]: %v", pin, errHex)
+	}
+	if len(raw) != sha256.Size {
+		return "", fmt.Errorf("parsePin: bad pin=[%s]: size=%d expected=%d", pin, len(raw), sha256.Size)
+	}
+	return p, nil
+}
+
+func verifyPin(pins map[string]bool, cs tls.ConnectionState) error {
+	if len(cs.PeerCertificates) < 1 {
+		return fmt.Errorf("verifyPin: missing peer certificate")
+	}
+	sum := sha256.Sum256(cs.PeerCertificates[0].Raw)
+	fingerprint := hex.EncodeToString(sum[:])
+	if !pins[fingerprint] {
+		return fmt.Errorf("verifyPin: peer certificate sha256=%s does not match pinned fingerprints", fingerprint)
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package a10go
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestTLSPinning(t *testing.T) {
+	ts := httptest.NewTLSServer(&fakeDevice{sessions: map[string]string{}})
+	defer ts.Close()
+
+	sum := sha256.Sum256(ts.Certificate().Raw)
+	pin := hex.EncodeToString(sum[:])
+	wrongPin := strings.Repeat("00", sha256.Size)
+
+	ca := x509.NewCertPool()
+	ca.AddCert(ts.Certificate())
+
+	table := []struct {
+		name string
+		opt  TLSOptions
+		ok   bool
+	}{
+		{"pin without CA", TLSOptions{PinSHA256: []string{pin}}, true},
+		{"wrong pin without CA", TLSOptions{PinSHA256: []string{wrongPin}}, false},
+		{"self-signed without options", TLSOptions{}, false},
+		{"CA without pin", TLSOptions{CAPool: ca}, true},
+		{"CA with pin", TLSOptions{CAPool: ca, PinSHA256: []string{pin}}, true},
+		{"CA with wrong pin", TLSOptions{CAPool: ca, PinSHA256: []string{wrongPin}}, false},
+	}
+
+	host := strings.TrimPrefix(ts.URL, "https://")
+
+	for _, data := range table {
+		c := New(host, Options{TLS: data.opt})
+		err := c.Login("admin", "a10")
+		if data.ok && err != nil {
+			t.Errorf("%s: unexpected error: %v", data.name, err)
+		}
+		if !data.ok && err == nil {
+			t.Errorf("%s: unexpected success", data.name)
+		}
+	}
+}
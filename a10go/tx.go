@@ -0,0 +1,303 @@
+package a10go
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Tx is a sequence of changes applied by Commit. If any change fails,
+// Commit reverts the changes already applied, in reverse order:
+// a create is reverted by delete, an update by restoring the previous
+// object, and a delete by re-creating the previous object.
+//
+//	tx := c.NewTx()
+//	tx.ServerCreate("s1", "10.0.0.1", []string{"80"})
+//	tx.ServiceGroupCreate("sg1", "2", []string{"s1,80"})
+//	tx.VirtualServerCreate("vs1", "10.0.0.100", []string{"sg1,80"})
+//	err := tx.Commit() // on failure, nothing is left behind
+//
+// Rollback is best effort: changes made to the device by others while the
+// transaction is running may prevent an exact restore.
+type Tx struct {
+	c     *Client
+	steps []txStep
+}
+
+// txStep applies a change and returns the function that reverts it
+type txStep struct {
+	desc  string
+	apply func() (undo func() error, err error)
+}
+
+// TxError reports a failed transaction
+type TxError struct {
+	Step        string  // failed step
+	Err         error   // step error
+	RollbackErr []error // errors found while reverting applied steps
+}
+
+func (e *TxError) Error() string {
+	if len(e.RollbackErr) == 0 {
+		return fmt.Sprintf("tx: step=%s: %v (rolled back)", e.Step, e.Err)
+	}
+	var errs []string
+	for _, r := range e.RollbackErr {
+		errs = append(errs, r.Error())
+	}
+	return fmt.Sprintf("tx: step=%s: %v (rollback failed: %s)", e.Step, e.Err, strings.Join(errs, "; "))
+}
+
+// Unwrap returns the step error
+func (e *TxError) Unwrap() error {
+	return e.Err
+}
+
+// Transaction is the set of operations implemented by Tx.
+// Code depending on API obtains a Transaction from API.Begin.
+type Transaction interface {
+	ServerCreate(name, host string, ports []string)
+	ServerUpdate(name, host string, ports []string)
+	ServerDelete(name string)
+	ServiceGroupCreate(name, protocol string, members []string)
+	ServiceGroupUpdate(name, protocol string, members []string)
+	ServiceGroupDelete(name string)
+	VirtualServerCreate(name, address string, virtualPorts []string)
+	VirtualServerUpdate(name, address string, virtualPorts []string)
+	VirtualServerDelete(name string)
+	Commit() error
+}
+
+var _ Transaction = (*Tx)(nil) // Tx must implement Transaction
+
+// NewTx creates an empty transaction
+func (c *Client) NewTx() *Tx {
+	return &Tx{c: c}
+}
+
+// Begin creates an empty transaction, like NewTx, as a Transaction
+func (c *Client) Begin() Transaction {
+	return c.NewTx()
+}
+
+func (tx *Tx) add(desc string, apply func() (func() error, error)) {
+	tx.steps = append(tx.steps, txStep{desc: desc, apply: apply})
+}
+
+// Commit applies all changes in order, reverting applied changes on failure.
+// On failure, the returned error is a *TxError.
+// On success, the configuration is saved if Options.AutoSave is set.
+func (tx *Tx) Commit() error {
+	c := tx.c
+
+	type done struct {
+		desc string
+		undo func() error
+	}
+	var applied []done
+
+	for _, s := range tx.steps {
+		c.debugf("tx: apply: %s", s.desc)
+		undo, err := s.apply()
+		if err == nil {
+			applied = append(applied, done{desc: s.desc, undo: undo})
+			continue
+		}
+
+		txErr := &TxError{Step: s.desc, Err: err}
+		for i := len(applied) - 1; i >= 0; i-- {
+			d := applied[i]
+			c.debugf("tx: rollback: %s", d.desc)
+			if errUndo := d.undo(); errUndo != nil {
+				txErr.RollbackErr = append(txErr.RollbackErr, fmt.Errorf("undo %s: %v", d.desc, errUndo))
+			}
+		}
+		return txErr
+	}
+
+	return autoSave(c, "tx")
+}
+
+// ServerCreate adds server creation to transaction
+func (tx *Tx) ServerCreate(name, host string, ports []string) {
+	c := tx.c
+	tx.add("ServerCreate "+name, func() (func() error, error) {
+		if err := c.ServerCreate(name, host, ports); err != nil {
+			return nil, err
+		}
+		return func() error { return c.ServerDelete(name) }, nil
+	})
+}
+
+// ServerUpdate adds server update to transaction
+func (tx *Tx) ServerUpdate(name, host string, ports []string) {
+	c := tx.c
+	tx.add("ServerUpdate "+name, func() (func() error, error) {
+		prev, err := txServer(c, name)
+		if err != nil {
+			return nil, err
+		}
+		if err := c.ServerUpdate(name, host, ports); err != nil {
+			return nil, err
+		}
+		return func() error { return c.ServerReplaceTemplate(name, prev.Host, prev.Template, portStrings(prev.Ports)) }, nil
+	})
+}
+
+// ServerDelete adds server deletion to transaction
+func (tx *Tx) ServerDelete(name string) {
+	c := tx.c
+	tx.add("ServerDelete "+name, func() (func() error, error) {
+		prev, err := txServer(c, name)
+		if err != nil {
+			return nil, err
+		}
+		if err := c.ServerDelete(name); err != nil {
+			return nil, err
+		}
+		return func() error { return c.ServerCreateTemplate(name, prev.Host, prev.Template, portStrings(prev.Ports)) }, nil
+	})
+}
+
+// ServiceGroupCreate adds service group creation to transaction
+func (tx *Tx) ServiceGroupCreate(name, protocol string, members []string) {
+	c := tx.c
+	tx.add("ServiceGroupCreate "+name, func() (func() error, error) {
+		if err := c.ServiceGroupCreate(name, protocol, members); err != nil {
+			return nil, err
+		}
+		return func() error { return c.ServiceGroupDelete(name) }, nil
+	})
+}
+
+// ServiceGroupUpdate adds service group update to transaction
+func (tx *Tx) ServiceGroupUpdate(name, protocol string, members []string) {
+	c := tx.c
+	tx.add("ServiceGroupUpdate "+name, func() (func() error, error) {
+		prev, err := txServiceGroup(c, name)
+		if err != nil {
+			return nil, err
+		}
+		if err := c.ServiceGroupUpdate(name, protocol, members); err != nil {
+			return nil, err
+		}
+		return func() error { return c.ServiceGroupReplace(name, prev.Protocol, memberStrings(prev.Members)) }, nil
+	})
+}
+
+// ServiceGroupDelete adds service group deletion to transaction
+func (tx *Tx) ServiceGroupDelete(name string) {
+	c := tx.c
+	tx.add("ServiceGroupDelete "+name, func() (func() error, error) {
+		prev, err := txServiceGroup(c, name)
+		if err != nil {
+			return nil, err
+		}
+		if err := c.ServiceGroupDelete(name); err != nil {
+			return nil, err
+		}
+		return func() error { return c.ServiceGroupCreate(name, prev.Protocol, memberStrings(prev.Members)) }, nil
+	})
+}
+
+// VirtualServerCreate adds virtual server creation to transaction
+func (tx *Tx) VirtualServerCreate(name, address string, virtualPorts []string) {
+	c := tx.c
+	tx.add("VirtualServerCreate "+name, func() (func() error, error) {
+		if err := c.VirtualServerCreate(name, address, virtualPorts); err != nil {
+			return nil, err
+		}
+		return func() error { return c.VirtualServerDelete(name) }, nil
+	})
+}
+
+// VirtualServerUpdate adds virtual server update to transaction
+func (tx *Tx) VirtualServerUpdate(name, address string, virtualPorts []string) {
+	c := tx.c
+	tx.add("VirtualServerUpdate "+name, func() (func() error, error) {
+		prev, err := txVirtualServer(c, name)
+		if err != nil {
+			return nil, err
+		}
+		if err := c.VirtualServerUpdate(name, address, virtualPorts); err != nil {
+			return nil, err
+		}
+		return func() error {
+			return c.VirtualServerReplaceTemplate(name, prev.Address, prev.Template, virtualPortStrings(prev.VirtualPorts))
+		}, nil
+	})
+}
+
+// VirtualServerDelete adds virtual server deletion to transaction
+func (tx *Tx) VirtualServerDelete(name string) {
+	c := tx.c
+	tx.add("VirtualServerDelete "+name, func() (func() error, error) {
+		prev, err := txVirtualServer(c, name)
+		if err != nil {
+			return nil, err
+		}
+		if err := c.VirtualServerDelete(name); err != nil {
+			return nil, err
+		}
+		return func() error {
+			// restore from parsed ports, keeping aFleX bindings
+			return virtualServerPostPorts(c, "slb.virtual_server.create", name, prev.Address, prev.Template, prev.VirtualPorts)
+		}, nil
+	})
+}
+
+// txServer retrieves current server state for later restore
+func txServer(c *Client, name string) (A10Server, error) {
+	list, errList := a10ServerList(c)
+	if errList != nil {
+		return A10Server{}, fmt.Errorf("server=%s: list: %v", name, errList)
+	}
+	s, found := findServer(list, name)
+	if !found {
+		return s, fmt.Errorf("server=%s: not found", name)
+	}
+	return s, nil
+}
+
+// txServiceGroup retrieves current service group state for later restore
+func txServiceGroup(c *Client, name string) (A10ServiceGroup, error) {
+	list, errList := a10ServiceGroupList(c)
+	if errList != nil {
+		return A10ServiceGroup{}, fmt.Errorf("service_group=%s: list: %v", name, errList)
+	}
+	g, found := findServiceGroup(list, name)
+	if !found {
+		return g, fmt.Errorf("service_group=%s: not found", name)
+	}
+	return g, nil
+}
+
+// txVirtualServer retrieves current virtual server state for later restore
+func txVirtualServer(c *Client, name string) (A10VServer, error) {
+	list, errList := a10VirtualServerList(c)
+	if errList != nil {
+		return A10VServer{}, fmt.Errorf("virtual_server=%s: list: %v", name, errList)
+	}
+	vs, found := findVirtualServer(list, name)
+	if !found {
+		return vs, fmt.Errorf("virtual_server=%s: not found", name)
+	}
+	return vs, nil
+}
+
+// memberStrings converts members to the "serverName,portNumber" form used by ServiceGroupCreate
+func memberStrings(members []A10SGMember) []string {
+	var list []string
+	for _, m := range members {
+		list = append(list, m.Name+","+m.Port)
+	}
+	return list
+}
+
+// virtualPortStrings converts virtual ports to the "serviceGroup,port,protocol[,field=value...]" form used by VirtualServerCreate
+func virtualPortStrings(ports []A10VirtualPort) []string {
+	var list []string
+	for _, p := range ports {
+		list = append(list, virtualPortString(p))
+	}
+	return list
+}
@@ -0,0 +1,91 @@
+package a10go
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// changes filters out list calls, keeping the calls that change configuration
+func changes(calls []fakeCall) []fakeCall {
+	var list []fakeCall
+	for _, call := range calls {
+		if !strings.HasSuffix(call.method, ".getAll") {
+			list = append(list, call)
+		}
+	}
+	return list
+}
+
+func TestTxRollbackCreate(t *testing.T) {
+	c, d, done := newFakeClient(t)
+	defer done()
+
+	d.failMethod("slb.virtual_server.create")
+
+	tx := c.NewTx()
+	tx.ServerCreate("s2", "10.0.0.2", []string{"80"})
+	tx.ServiceGroupCreate("sg2", "2", []string{"s2,80"})
+	tx.VirtualServerCreate("vs2", "10.0.0.100", []string{"sg2,80"})
+	err := tx.Commit()
+
+	var txErr *TxError
+	if !errors.As(err, &txErr) {
+		t.Fatalf("expected *TxError, got: %v", err)
+	}
+	if txErr.Step != "VirtualServerCreate vs2" || len(txErr.RollbackErr) != 0 {
+		t.Errorf("unexpected error: %v", txErr)
+	}
+
+	expected := []string{
+		"slb.server.create",
+		"slb.service_group.create",
+		"slb.virtual_server.create",
+		"slb.service_group.delete", // rollback in reverse order
+		"slb.server.delete",
+	}
+	calls := changes(d.received())
+	if len(calls) != len(expected) {
+		t.Fatalf("expected calls %v, got %v", expected, calls)
+	}
+	for i, call := range calls {
+		if call.method != expected[i] {
+			t.Errorf("call %d: expected %s, got %s", i, expected[i], call.method)
+		}
+	}
+	if !strings.Contains(calls[3].body, `"sg2"`) || !strings.Contains(calls[4].body, `"s2"`) {
+		t.Errorf("rollback deleted wrong objects: %v", calls[3:])
+	}
+}
+
+func TestTxRollbackUpdate(t *testing.T) {
+	c, d, done := newFakeClient(t)
+	defer done()
+
+	d.failMethod("slb.virtual_server.create")
+
+	tx := c.NewTx()
+	tx.ServerUpdate("s1", "10.0.0.2", []string{"80"})
+	tx.VirtualServerCreate("vs2", "10.0.0.100", []string{"sg2,80"})
+	err := tx.Commit()
+
+	var txErr *TxError
+	if !errors.As(err, &txErr) {
+		t.Fatalf("expected *TxError, got: %v", err)
+	}
+	if len(txErr.RollbackErr) != 0 {
+		t.Errorf("unexpected rollback error: %v", txErr)
+	}
+
+	calls := changes(d.received())
+	if len(calls) != 3 {
+		t.Fatalf("expected update, failed create and restore, got %v", calls)
+	}
+	update, restore := calls[0], calls[2]
+	if update.method != "slb.server.update" || !strings.Contains(update.body, `"10.0.0.2"`) {
+		t.Errorf("unexpected update: %v", update)
+	}
+	if restore.method != "slb.server.update" || !strings.Contains(restore.body, `"10.0.0.1"`) {
+		t.Errorf("server not restored to previous host: %v", restore)
+	}
+}
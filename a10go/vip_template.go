@@ -0,0 +1,82 @@
+package a10go
+
+// A10VirtualServerTemplate is a virtual-server template (slb.template.virtual_server),
+// protecting a VIP as a whole
+type A10VirtualServerTemplate struct {
+	Name               string `json:"name"`
+	ConnLimit          *int   `json:"conn_limit,omitempty"`
+	ConnLimitReset     *Flag  `json:"conn_limit_reset,omitempty"` // reset connections over the limit, instead of dropping them
+	ConnRateLimit      *int   `json:"conn_rate_limit,omitempty"`  // new connections per second
+	ConnRateLimitReset *Flag  `json:"conn_rate_limit_reset,omitempty"`
+	ICMPRateLimit      *int   `json:"icmp_rate_limit,omitempty"`    // ICMP packets per second
+	ICMPLockup         *int   `json:"icmp_lockup,omitempty"`        // ICMP packets per second triggering lockup
+	ICMPLockupPeriod   *int   `json:"icmp_lockup_period,omitempty"` // seconds ICMP is dropped after lockup
+}
+
+// A10VirtualPortTemplate is a virtual-port template (slb.template.virtual_port),
+// protecting individual virtual ports
+type A10VirtualPortTemplate struct {
+	Name               string `json:"name"`
+	ConnLimit          *int   `json:"conn_limit,omitempty"`
+	ConnLimitReset     *Flag  `json:"conn_limit_reset,omitempty"`
+	ConnRateLimit      *int   `json:"conn_rate_limit,omitempty"` // new connections per second
+	ConnRateLimitReset *Flag  `json:"conn_rate_limit_reset,omitempty"`
+	SYNCookie          *Flag  `json:"syn_cookie,omitempty"`         // answer SYN floods with SYN cookies
+	ResetUnknownConn   *Flag  `json:"reset_unknown_conn,omitempty"` // reset packets of unknown connections
+	DropUnknownConn    *Flag  `json:"drop_unknown_conn,omitempty"`  // drop packets of unknown connections
+}
+
+const (
+	prefixVirtualServerTemplate = "slb.template.virtual_server"
+	keyVirtualServerTemplate    = "virtual_server_template"
+	prefixVirtualPortTemplate   = "slb.template.virtual_port"
+	keyVirtualPortTemplate      = "virtual_port_template"
+)
+
+// VirtualServerTemplateList retrieves virtual-server templates
+func (c *Client) VirtualServerTemplateList() []A10VirtualServerTemplate {
+	var list []A10VirtualServerTemplate
+	if err := templateGetAll(c, prefixVirtualServerTemplate, keyVirtualServerTemplate, &list); err != nil {
+		c.debugf("VirtualServerTemplateList: %v", err)
+	}
+	return list
+}
+
+// VirtualServerTemplateCreate creates virtual-server template
+func (c *Client) VirtualServerTemplateCreate(t A10VirtualServerTemplate) error {
+	return templatePost(c, prefixVirtualServerTemplate, keyVirtualServerTemplate, "create", t)
+}
+
+// VirtualServerTemplateUpdate updates virtual-server template
+func (c *Client) VirtualServerTemplateUpdate(t A10VirtualServerTemplate) error {
+	return templatePost(c, prefixVirtualServerTemplate, keyVirtualServerTemplate, "update", t)
+}
+
+// VirtualServerTemplateDelete deletes virtual-server template
+func (c *Client) VirtualServerTemplateDelete(name string) error {
+	return templateDelete(c, prefixVirtualServerTemplate, name)
+}
+
+// VirtualPortTemplateList retrieves virtual-port templates
+func (c *Client) VirtualPortTemplateList() []A10VirtualPortTemplate {
+	var list []A10VirtualPortTemplate
+	if err := templateGetAll(c, prefixVirtualPortTemplate, keyVirtualPortTemplate, &list); err != nil {
+		c.debugf("VirtualPortTemplateList: %v", err)
+	}
+	return list
+}
+
+// VirtualPortTemplateCreate creates virtual-port template
+func (c *Client) VirtualPortTemplateCreate(t A10VirtualPortTemplate) error {
+	return templatePost(c, prefixVirtualPortTemplate, keyVirtualPortTemplate, "create", t)
+}
+
+// VirtualPortTemplateUpdate updates virtual-port template
+func (c *Client) VirtualPortTemplateUpdate(t A10VirtualPortTemplate) error {
+	return templatePost(c, prefixVirtualPortTemplate, keyVirtualPortTemplate, "update", t)
+}
+
+// VirtualPortTemplateDelete deletes virtual-port template
+func (c *Client) VirtualPortTemplateDelete(name string) error {
+	return templateDelete(c, prefixVirtualPortTemplate, name)
+}
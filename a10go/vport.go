@@ -0,0 +1,157 @@
+package a10go
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Virtual ports are given to VirtualServerCreate and friends as strings:
+//
+//	"serviceGroup,port,protocol[,field=value...]"
+//
+// The optional field=value elements reference templates and other objects
+// by name, using the aXAPI vport field name. For instance:
+//
+//	"sg1,80,2,cookie_persistence_template=cookie1"
+//
+// Supported fields are listed in vportFields.
+//
+// For example, an HTTPS virtual port terminating SSL with client-SSL template "cs1":
+//
+//	"sg1,443,12,client_ssl_template=cs1"
+//
+// And a one-armed virtual port translating client addresses with NAT pool "snat1":
+//
+//	"sg1,80,2,source_nat=snat1"
+
+// Virtual port protocols
+const (
+	VirtualPortTCP   = "2"
+	VirtualPortUDP   = "3"
+	VirtualPortHTTP  = "11"
+	VirtualPortHTTPS = "12"
+)
+
+// vportField maps an aXAPI vport field to A10VirtualPort
+type vportField struct {
+	key   string
+	field func(p *A10VirtualPort) *string
+}
+
+var vportFields = []vportField{
+	{"cookie_persistence_template", func(p *A10VirtualPort) *string { return &p.CookiePersistenceTemplate }},
+	{"source_ip_persistence_template", func(p *A10VirtualPort) *string { return &p.SourceIPPersistenceTemplate }},
+	{"destination_ip_persistence_template", func(p *A10VirtualPort) *string { return &p.DestinationIPPersistenceTemplate }},
+	{"client_ssl_template", func(p *A10VirtualPort) *string { return &p.ClientSSLTemplate }},
+	{"server_ssl_template", func(p *A10VirtualPort) *string { return &p.ServerSSLTemplate }},
+	{"http_template", func(p *A10VirtualPort) *string { return &p.HTTPTemplate }},
+	{"tcp_template", func(p *A10VirtualPort) *string { return &p.TCPTemplate }},
+	{"udp_template", func(p *A10VirtualPort) *string { return &p.UDPTemplate }},
+	{"conn_reuse_template", func(p *A10VirtualPort) *string { return &p.ConnReuseTemplate }},
+	{"vport_template", func(p *A10VirtualPort) *string { return &p.VirtualPortTemplate }},
+	{"source_nat", func(p *A10VirtualPort) *string { return &p.SourceNAT }},
+}
+
+// parseVirtualPort parses "serviceGroup,port,protocol[,field=value...]"
+func parseVirtualPort(debugf FuncPrintf, virtualPort string) A10VirtualPort {
+	var positional []string
+	options := map[string]string{}
+
+	for _, f := range strings.FieldsFunc(virtualPort, isSep) {
+		kv := strings.SplitN(f, "=", 2)
+		if len(kv) < 2 {
+			positional = append(positional, f)
+			continue
+		}
+		options[kv[0]] = kv[1]
+	}
+
+	p := A10VirtualPort{Protocol: defaultProtoTCP}
+
+	switch count := len(positional); {
+	case count < 2:
+		debugf("parseVirtualPort(%s): missing serviceGroup,port", virtualPort)
+	case count < 3:
+		p.ServiceGroup, p.Port = positional[0], positional[1]
+	default:
+		p.ServiceGroup, p.Port, p.Protocol = positional[0], positional[1], positional[2]
+	}
+
+	for _, vf := range vportFields {
+		if value, found := options[vf.key]; found {
+			*vf.field(&p) = value
+			delete(options, vf.key)
+		}
+	}
+	for k := range options {
+		debugf("parseVirtualPort(%s): ignoring unsupported field=%s", virtualPort, k)
+	}
+
+	return p
+}
+
+// virtualPortFormat renders virtual port as aXAPI json
+func virtualPortFormat(p A10VirtualPort) string {
+	return virtualPortResetFormat(p, A10VirtualPort{})
+}
+
+// virtualPortResetFormat renders virtual port as aXAPI json, explicitly clearing
+// the fields set on current but not on p, since the device keeps omitted fields
+func virtualPortResetFormat(p, current A10VirtualPort) string {
+	str := fmt.Sprintf(`{"port": %s, "service_group": "%s", "protocol": "%s"`, p.Port, p.ServiceGroup, p.Protocol)
+	for _, vf := range vportFields {
+		value := *vf.field(&p)
+		if value == "" && *vf.field(&current) == "" {
+			continue
+		}
+		str += fmt.Sprintf(`, "%s": "%s"`, vf.key, value)
+	}
+	if p.AFlex != nil {
+		var list []string
+		for _, a := range p.AFlex {
+			list = append(list, fmt.Sprintf(`{"aflex": "%s"}`, a))
+		}
+		str += fmt.Sprintf(`, "aflex_list": [%s]`, strings.Join(list, ", "))
+	}
+	return str + "}"
+}
+
+// virtualPortString renders virtual port in the string form accepted by VirtualServerCreate.
+// AFlex bindings have no string form, see virtualServerPostPorts.
+func virtualPortString(p A10VirtualPort) string {
+	str := p.ServiceGroup + "," + p.Port + "," + p.Protocol
+	for _, vf := range vportFields {
+		if value := *vf.field(&p); value != "" {
+			str += "," + vf.key + "=" + value
+		}
+	}
+	return str
+}
+
+// virtualPortKey is a comparable key for virtual port, including referenced objects
+func virtualPortKey(p A10VirtualPort) string {
+	var options []string
+	for _, vf := range vportFields {
+		if value := *vf.field(&p); value != "" {
+			options = append(options, vf.key+"="+value)
+		}
+	}
+	sort.Strings(options)
+	return strings.Join(append([]string{p.ServiceGroup, p.Port, p.Protocol}, options...), "/")
+}
+
+// parseVirtualPortFields fills virtual port fields found in aXAPI vport map
+func parseVirtualPortFields(debugf FuncPrintf, p *A10VirtualPort, pMap map[string]interface{}) {
+	for _, vf := range vportFields {
+		if _, found := pMap[vf.key]; found {
+			*vf.field(p) = mapGetStr(debugf, pMap, vf.key)
+		}
+	}
+	aList, _ := pMap["aflex_list"].([]interface{})
+	for _, a := range aList {
+		if aMap, isMap := a.(map[string]interface{}); isMap {
+			p.AFlex = append(p.AFlex, mapGetStr(debugf, aMap, "aflex"))
+		}
+	}
+}
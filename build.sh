@@ -14,8 +14,13 @@ build() {
 }
 
 build ./a10go
+build ./a10go/a10gomock
+build ./examples/a10cli
+build ./examples/a10ctl
 build ./examples/a10list
 build ./examples/a10server
 build ./examples/a10sgroup
 build ./examples/a10vserver
 
+# session handling is shared across goroutines
+go test -race ./a10go
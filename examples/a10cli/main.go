@@ -0,0 +1,61 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/udhos/a10-go-rest-client/a10go"
+)
+
+func main() {
+	me := os.Args[0]
+	if len(os.Args) < 5 {
+		fmt.Printf("usage:   %s host         username password show|deploy command...\n", me)
+		fmt.Printf("example: %s 10.255.255.6 admin    a10      show 'show slb server'\n", me)
+		fmt.Printf("example: %s 10.255.255.6 admin    a10      deploy 'slb server s1 1.1.1.1' 'port 80 tcp'\n", me)
+		return
+	}
+
+	host := os.Args[1]
+	user := os.Args[2]
+	pass := os.Args[3]
+	mode := os.Args[4]
+	commands := os.Args[5:]
+
+	debug := os.Getenv("DEBUG") != ""
+	fmt.Printf("%s: debug=%v DEBUG=[%s]\n", me, debug, os.Getenv("DEBUG"))
+
+	insecure := os.Getenv("INSECURE") != ""
+	fmt.Printf("%s: insecure=%v INSECURE=[%s]\n", me, insecure, os.Getenv("INSECURE"))
+
+	c := a10go.New(host, a10go.Options{Debug: debug, TLS: a10go.TLSOptions{InsecureSkipVerify: insecure}})
+
+	errLogin := c.Login(user, pass)
+	if errLogin != nil {
+		fmt.Printf("login failure: %v\n", errLogin)
+		return
+	}
+
+	var output string
+	var errCmd error
+
+	switch mode {
+	case "show":
+		output, errCmd = c.CLIShow(strings.Join(commands, " "))
+	case "deploy":
+		output, errCmd = c.CLIDeploy(commands)
+	default:
+		fmt.Printf("%s: bad mode=%s, expecting show or deploy\n", me, mode)
+	}
+
+	fmt.Print(output)
+	if errCmd != nil {
+		fmt.Printf("%s: error: %v\n", mode, errCmd)
+	}
+
+	errLogout := c.Logout()
+	if errLogout != nil {
+		fmt.Printf("logout failure: %v\n", errLogout)
+	}
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/udhos/a10-go-rest-client/a10go"
+)
+
+func usage(me string) {
+	fmt.Printf("usage:   %s host         username password cert list\n", me)
+	fmt.Printf("         %s host         username password cert expiring [-within 30d]\n", me)
+	fmt.Printf("         %s host         username password cert rotate -template T -name N -cert F [-key F] [-passphrase P]\n", me)
+	fmt.Printf("example: %s 10.255.255.6 admin    a10      cert expiring -within 30d\n", me)
+}
+
+func main() {
+	me := os.Args[0]
+	if len(os.Args) < 6 || os.Args[4] != "cert" {
+		usage(me)
+		return
+	}
+
+	host := os.Args[1]
+	user := os.Args[2]
+	pass := os.Args[3]
+	cmd := os.Args[5]
+	args := os.Args[6:]
+
+	debug := os.Getenv("DEBUG") != ""
+	fmt.Printf("%s: debug=%v DEBUG=[%s]\n", me, debug, os.Getenv("DEBUG"))
+
+	insecure := os.Getenv("INSECURE") != ""
+	fmt.Printf("%s: insecure=%v INSECURE=[%s]\n", me, insecure, os.Getenv("INSECURE"))
+
+	c := a10go.New(host, a10go.Options{Debug: debug, TLS: a10go.TLSOptions{InsecureSkipVerify: insecure}})
+
+	errLogin := c.Login(user, pass)
+	if errLogin != nil {
+		fmt.Printf("login failure: %v\n", errLogin)
+		return
+	}
+
+	switch cmd {
+	case "list":
+		certList(c)
+	case "expiring":
+		certExpiring(c, args)
+	case "rotate":
+		certRotate(c, args)
+	default:
+		usage(me)
+	}
+
+	errLogout := c.Logout()
+	if errLogout != nil {
+		fmt.Printf("logout failure: %v\n", errLogout)
+	}
+}
+
+func certList(c *a10go.Client) {
+	for _, cert := range c.SSLCertList() {
+		fmt.Printf("%s notAfter=%s key=%v subject=[%s] issuer=[%s]\n", cert.Name, cert.NotAfter.Format(time.RFC3339), cert.HasKey, cert.Subject, cert.Issuer)
+	}
+}
+
+func certExpiring(c *a10go.Client, args []string) {
+	flags := flag.NewFlagSet("cert expiring", flag.ExitOnError)
+	within := flags.String("within", "30d", "report certificates expiring within this duration (e.g. 30d, 12h)")
+	flags.Parse(args)
+
+	d, errDur := parseDuration(*within)
+	if errDur != nil {
+		fmt.Printf("cert expiring: %v\n", errDur)
+		return
+	}
+
+	report, errReport := c.CertExpiring(d)
+	if errReport != nil {
+		fmt.Printf("cert expiring: %v\n", errReport)
+		return
+	}
+
+	fmt.Printf("certificates expiring within %s: %d\n", *within, len(report))
+	for _, e := range report {
+		notAfter := e.Cert.NotAfter.Format(time.RFC3339)
+		if e.Unknown {
+			notAfter = "unknown"
+		}
+		fmt.Printf("%s notAfter=%s templates=%v\n", e.Cert.Name, notAfter, e.Templates)
+		for _, vip := range e.VirtualPorts {
+			fmt.Printf("    vip: virtual_server=%s address=%s port=%s template=%s\n", vip.VirtualServer, vip.Address, vip.Port, vip.Template)
+		}
+	}
+}
+
+func certRotate(c *a10go.Client, args []string) {
+	flags := flag.NewFlagSet("cert rotate", flag.ExitOnError)
+	template := flags.String("template", "", "client-SSL template to switch to the new certificate")
+	name := flags.String("name", "", "name for the new certificate")
+	certFile := flags.String("cert", "", "PEM certificate file")
+	keyFile := flags.String("key", "", "PEM private key file")
+	passphrase := flags.String("passphrase", "", "private key passphrase")
+	flags.Parse(args)
+
+	if *template == "" || *name == "" || *certFile == "" {
+		fmt.Printf("cert rotate: missing -template, -name or -cert\n")
+		return
+	}
+
+	certPEM, errCert := ioutil.ReadFile(*certFile)
+	if errCert != nil {
+		fmt.Printf("cert rotate: %v\n", errCert)
+		return
+	}
+
+	var keyPEM []byte
+	if *keyFile != "" {
+		var errKey error
+		keyPEM, errKey = ioutil.ReadFile(*keyFile)
+		if errKey != nil {
+			fmt.Printf("cert rotate: %v\n", errKey)
+			return
+		}
+	}
+
+	cert, errRotate := c.CertRotate(*template, *name, certPEM, keyPEM, *passphrase)
+	fmt.Printf("rotating template=%s cert=%s notAfter=%s error:%v\n", *template, cert.Name, cert.NotAfter.Format(time.RFC3339), errRotate)
+}
+
+// parseDuration accepts time.ParseDuration formats plus the days suffix "d"
+func parseDuration(s string) (time.Duration, error) {
+	if strings.HasSuffix(s, "d") {
+		days, err := strconv.Atoi(strings.TrimSuffix(s, "d"))
+		if err != nil {
+			return 0, fmt.Errorf("bad duration: %s", s)
+		}
+		return time.Duration(days) * 24 * time.Hour, nil
+	}
+	return time.ParseDuration(s)
+}
@@ -24,7 +24,13 @@ func main() {
 
 	fmt.Printf("%s: debug=%v DEBUG=[%s]\n", me, debug, os.Getenv("DEBUG"))
 
-	c := a10go.New(host, a10go.Options{Debug: debug})
+	insecure := os.Getenv("INSECURE") != ""
+	fmt.Printf("%s: insecure=%v INSECURE=[%s]\n", me, insecure, os.Getenv("INSECURE"))
+
+	partition := os.Getenv("PARTITION")
+	fmt.Printf("%s: partition=%s PARTITION=[%s]\n", me, partition, os.Getenv("PARTITION"))
+
+	c := a10go.New(host, a10go.Options{Debug: debug, Partition: partition, TLS: a10go.TLSOptions{InsecureSkipVerify: insecure}})
 
 	errLogin := c.Login(user, pass)
 	if errLogin != nil {
@@ -32,6 +38,10 @@ func main() {
 		return
 	}
 
+	fmt.Printf("partitions:\n")
+	partitions := c.PartitionList()
+	litter.Dump(partitions)
+
 	fmt.Printf("virtual servers:\n")
 	vServers := c.VirtualServerList()
 	litter.Dump(vServers)
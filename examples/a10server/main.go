@@ -37,7 +37,10 @@ func main() {
 	ports := strings.Fields(portList)
 	fmt.Printf("%s: ports=%v PORTS=[%s]\n", me, ports, os.Getenv("PORTS"))
 
-	c := a10go.New(host, a10go.Options{Debug: debug})
+	insecure := os.Getenv("INSECURE") != ""
+	fmt.Printf("%s: insecure=%v INSECURE=[%s]\n", me, insecure, os.Getenv("INSECURE"))
+
+	c := a10go.New(host, a10go.Options{Debug: debug, TLS: a10go.TLSOptions{InsecureSkipVerify: insecure}})
 
 	errLogin := c.Login(user, pass)
 	if errLogin != nil {
@@ -50,6 +53,7 @@ func main() {
 
 	create(c, serverName, ports)
 	create(c, serverName, ports)
+	ensure(c, serverName, ports) // unchanged
 
 	fmt.Printf("\nafter servers:\n")
 	litter.Dump(c.ServerList())
@@ -57,7 +61,7 @@ func main() {
 	update(c, "intentional-non-existant-server-name", ports)
 
 	p7 := []string{"7777"}
-	update(c, serverName, p7) // will add the port to list
+	update(c, serverName, p7) // will add the port to list (merge semantics)
 
 	fmt.Printf("\nafter updating ports=%v:\n", p7)
 	litter.Dump(c.ServerList())
@@ -72,6 +76,12 @@ func main() {
 	fmt.Printf("\nafter updating ports=%v:\n", p7)
 	litter.Dump(c.ServerList())
 
+	errReplace := c.ServerReplace(serverName, "99.99.99.99", ports) // exactly these ports
+	fmt.Printf("replacing server=%s ports=%v error:%v\n", serverName, ports, errReplace)
+
+	fmt.Printf("\nafter replacing ports=%v:\n", ports)
+	litter.Dump(c.ServerList())
+
 	destroy(c, serverName)
 	destroy(c, serverName)
 
@@ -89,6 +99,11 @@ func create(c *a10go.Client, serverName string, ports []string) {
 	fmt.Printf("creating server=%s ports=%v error:%v\n", serverName, ports, errCreate)
 }
 
+func ensure(c *a10go.Client, serverName string, ports []string) {
+	result, errEnsure := c.ServerEnsure(serverName, "99.99.99.99", ports)
+	fmt.Printf("ensuring server=%s ports=%v result=%v error:%v\n", serverName, ports, result, errEnsure)
+}
+
 func update(c *a10go.Client, serverName string, ports []string) {
 	errUpdate := c.ServerUpdate(serverName, "99.99.99.99", ports)
 	fmt.Printf("updating server=%s ports=%v error:%v\n", serverName, ports, errUpdate)
@@ -30,7 +30,10 @@ func main() {
 	}
 	fmt.Printf("%s: serverCount=%d SERVERS=[%s]\n", me, serverCount, os.Getenv("SERVERS"))
 
-	c := a10go.New(host, a10go.Options{Debug: debug})
+	insecure := os.Getenv("INSECURE") != ""
+	fmt.Printf("%s: insecure=%v INSECURE=[%s]\n", me, insecure, os.Getenv("INSECURE"))
+
+	c := a10go.New(host, a10go.Options{Debug: debug, TLS: a10go.TLSOptions{InsecureSkipVerify: insecure}})
 
 	errLogin := c.Login(user, pass)
 	if errLogin != nil {
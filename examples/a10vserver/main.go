@@ -12,7 +12,7 @@ import (
 func main() {
 	me := os.Args[0]
 
-	fmt.Printf(me + " version 0.0\n")
+	fmt.Printf("%s version 0.0\n", me)
 
 	if len(os.Args) != 4 {
 		fmt.Printf("usage:   %s host         username password\n", me)
@@ -41,7 +41,10 @@ func main() {
 	}
 	fmt.Printf("%s: groupCount=%d %s=[%s]\n", me, groupCount, groupKey, groupValue)
 
-	c := a10go.New(host, a10go.Options{Debug: debug})
+	insecure := os.Getenv("INSECURE") != ""
+	fmt.Printf("%s: insecure=%v INSECURE=[%s]\n", me, insecure, os.Getenv("INSECURE"))
+
+	c := a10go.New(host, a10go.Options{Debug: debug, TLS: a10go.TLSOptions{InsecureSkipVerify: insecure}})
 
 	errLogin := c.Login(user, pass)
 	if errLogin != nil {
@@ -146,15 +149,24 @@ func createServers(c *a10go.Client, prefixServer, prefixAddr string, serverCount
 	var serverList []string
 	var serverPortList []string
 
+	var servers []a10go.A10Server
 	for i := 0; i < serverCount; i++ {
 		s := fmt.Sprintf(prefixServer+"%02d", i)
 		a := fmt.Sprintf(prefixAddr+"%d", i)
-		errCreate := c.ServerCreate(s, a, []string{"8888", "9999"})
-		fmt.Printf("creating %d/%d server=[%s] error:%v\n", i, serverCount, s, errCreate)
-		if errCreate != nil {
-			return nil, nil
-		}
-		serverList = append(serverList, s)
+		ports := []a10go.A10Port{{Number: "8888"}, {Number: "9999"}}
+		servers = append(servers, a10go.A10Server{Name: s, Host: a, Ports: ports})
+	}
+
+	results, errCreate := c.ServerCreateBatch(servers, a10go.BatchOptions{StopOnError: true})
+	for _, r := range results {
+		fmt.Printf("creating %d/%d server=[%s] error:%v\n", r.Index, serverCount, r.Name, r.Err)
+	}
+	if errCreate != nil {
+		return nil, nil
+	}
+
+	for _, s := range servers {
+		serverList = append(serverList, s.Name)
 	}
 
 	for _, s := range serverList {
@@ -165,8 +177,8 @@ func createServers(c *a10go.Client, prefixServer, prefixAddr string, serverCount
 }
 
 func deleteServers(c *a10go.Client, serverList []string) {
-	for i, s := range serverList {
-		errDelete := c.ServerDelete(s)
-		fmt.Printf("deleting %d/%d server=[%s] error:%v\n", i, len(serverList), s, errDelete)
+	results, _ := c.ServerDeleteBatch(serverList, a10go.BatchOptions{})
+	for _, r := range results {
+		fmt.Printf("deleting %d/%d server=[%s] error:%v\n", r.Index, len(serverList), r.Name, r.Err)
 	}
 }